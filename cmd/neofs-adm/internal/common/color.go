@@ -0,0 +1,75 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// Supported values of the commonflags.Color flag.
+const (
+	ColorAuto   = "auto"
+	ColorAlways = "always"
+	ColorNever  = "never"
+)
+
+// ANSI escape codes used to color the output.
+const (
+	ColorBold   = "\033[1m"
+	ColorRed    = "\033[31m"
+	ColorGreen  = "\033[32m"
+	ColorYellow = "\033[33m"
+
+	colorReset = "\033[0m"
+)
+
+// noColorEnv disables colors in auto mode, see https://no-color.org.
+const noColorEnv = "NO_COLOR"
+
+// CheckColorMode returns an error if commonflags.Color value is not supported.
+func CheckColorMode() error {
+	switch m := viper.GetString(commonflags.Color); m {
+	case ColorAuto, ColorAlways, ColorNever:
+		return nil
+	default:
+		return fmt.Errorf("unsupported color mode '%s', must be one of %s, %s, %s", m, ColorAuto, ColorAlways, ColorNever)
+	}
+}
+
+// UseColor checks whether the output to w should be colored. In auto mode
+// colors are used only for terminals unless NO_COLOR environment
+// variable is set. commonflags.NoColor flag disables colors in any mode.
+func UseColor(w io.Writer) bool {
+	if viper.GetBool(commonflags.NoColor) {
+		return false
+	}
+
+	switch viper.GetString(commonflags.Color) {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+
+	if _, ok := os.LookupEnv(noColorEnv); ok {
+		return false
+	}
+
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// Colorize wraps s into the color escape sequence if the command
+// output should be colored (see UseColor), otherwise s is returned as is.
+func Colorize(cmd *cobra.Command, color, s string) string {
+	if !UseColor(cmd.OutOrStdout()) {
+		return s
+	}
+
+	return color + s + colorReset
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColorize(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	cmd := new(cobra.Command)
+	cmd.SetOut(new(bytes.Buffer))
+
+	viper.Set(commonflags.Color, ColorAuto)
+	require.NoError(t, CheckColorMode())
+	require.Equal(t, "text", Colorize(cmd, ColorRed, "text"), "buffer is not a terminal")
+
+	viper.Set(commonflags.Color, ColorAlways)
+	require.Equal(t, ColorRed+"text"+colorReset, Colorize(cmd, ColorRed, "text"))
+
+	viper.Set(commonflags.NoColor, true)
+	require.Equal(t, "text", Colorize(cmd, ColorRed, "text"))
+	viper.Set(commonflags.NoColor, false)
+
+	viper.Set(commonflags.Color, ColorNever)
+	require.Equal(t, "text", Colorize(cmd, ColorRed, "text"))
+
+	viper.Set(commonflags.Color, "rainbow")
+	require.Error(t, CheckColorMode())
+}
@@ -0,0 +1,11 @@
+package common
+
+import "github.com/spf13/cobra"
+
+// CompleteValues returns shell completion function suggesting
+// the given flag values.
+func CompleteValues(values ...string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,177 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	utilConfig "github.com/nspcc-dev/neofs-node/pkg/util/config"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// ConfigConflict describes a config key set to different values
+// by the config file and the config directory.
+type ConfigConflict struct {
+	Key       string
+	FileValue interface{}
+	DirValue  interface{}
+}
+
+func (x ConfigConflict) String() string {
+	return fmt.Sprintf("'%s' is set to '%v' in config file and to '%v' in config directory",
+		x.Key, x.FileValue, x.DirValue)
+}
+
+var (
+	// defaultConfigFile is a config file found by ReadConfig in DefaultConfigPaths.
+	defaultConfigFile string
+	// defaultConfigDir is a config directory found by ReadConfig in DefaultConfigDirPaths.
+	defaultConfigDir string
+)
+
+// DefaultConfigPaths returns paths where the config file is looked up if
+// commonflags.ConfigFlag is not set, in the lookup order.
+func DefaultConfigPaths() []string {
+	paths := []string{"config.yaml"}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "neofs-adm", "config.yaml"))
+	}
+
+	return append(paths, "/etc/neofs-adm/config.yaml")
+}
+
+// DefaultConfigFile returns the path of the config file found by ReadConfig
+// in DefaultConfigPaths. Returns empty string if the config file was set
+// explicitly or no file was found.
+func DefaultConfigFile() string {
+	return defaultConfigFile
+}
+
+// DefaultConfigDirPaths returns paths where the config directory is looked
+// up if commonflags.ConfigDirFlag is not set, in the lookup order.
+func DefaultConfigDirPaths() []string {
+	paths := []string{"config.d"}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "neofs-adm", "config.d"))
+	}
+
+	return append(paths, "/etc/neofs-adm/config.d")
+}
+
+// DefaultConfigDir returns the path of the config directory found by
+// ReadConfig in DefaultConfigDirPaths. Returns empty string if the config
+// directory was set explicitly or no directory was found.
+func DefaultConfigDir() string {
+	return defaultConfigDir
+}
+
+func findDefaultConfig() string {
+	for _, p := range DefaultConfigPaths() {
+		if fi, err := os.Stat(p); err == nil && fi.Mode().IsRegular() {
+			return p
+		}
+	}
+
+	return ""
+}
+
+func findDefaultConfigDir() string {
+	for _, p := range DefaultConfigDirPaths() {
+		if fi, err := os.Stat(p); err == nil && fi.IsDir() {
+			return p
+		}
+	}
+
+	return ""
+}
+
+// ReadConfig reads the config file and the config directory set via
+// commonflags.ConfigFlag and commonflags.ConfigDirFlag and merges them
+// into v. The config file is loaded first, then the config directory is
+// merged on top of it, so directory values override config file ones.
+// If the config file or the directory is not set, the first existing one
+// from DefaultConfigPaths or DefaultConfigDirPaths respectively is used.
+// Unavailable config file or directory is ignored.
+//
+// Returns keys set to different values by the config file and the config directory.
+func ReadConfig(v *viper.Viper) []ConfigConflict {
+	configFile := v.GetString(commonflags.ConfigFlag)
+
+	defaultConfigFile = ""
+	if configFile == "" {
+		configFile = findDefaultConfig()
+		defaultConfigFile = configFile
+	}
+
+	fileV := viper.New()
+	if configFile != "" {
+		fileV.SetConfigType("yml")
+		fileV.SetConfigFile(configFile)
+		_ = fileV.ReadInConfig()
+	}
+
+	configDir := v.GetString(commonflags.ConfigDirFlag)
+
+	defaultConfigDir = ""
+	if configDir == "" {
+		configDir = findDefaultConfigDir()
+		defaultConfigDir = configDir
+	}
+
+	dirV := viper.New()
+	if configDir != "" {
+		_ = utilConfig.ReadConfigDir(dirV, configDir)
+	}
+
+	_ = v.MergeConfigMap(fileV.AllSettings())
+	_ = v.MergeConfigMap(dirV.AllSettings())
+
+	var res []ConfigConflict
+
+	for _, key := range dirV.AllKeys() {
+		if !fileV.IsSet(key) {
+			continue
+		}
+
+		fileVal, dirVal := fileV.Get(key), dirV.Get(key)
+		if !reflect.DeepEqual(fileVal, dirVal) {
+			res = append(res, ConfigConflict{
+				Key:       key,
+				FileValue: fileVal,
+				DirValue:  dirVal,
+			})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+
+	return res
+}
+
+// CheckConfigConflicts logs a warning for each conflict. If the
+// commonflags.StrictConfig flag is set, an error is returned instead.
+func CheckConfigConflicts(conflicts []ConfigConflict) error {
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	if viper.GetBool(commonflags.StrictConfig) {
+		return fmt.Errorf("config conflict: %s", conflicts[0])
+	}
+
+	for i := range conflicts {
+		Logger().Warn("config directory value overrides config file value",
+			zap.String("key", conflicts[i].Key),
+			zap.Any("file", conflicts[i].FileValue),
+			zap.Any("dir", conflicts[i].DirValue),
+		)
+	}
+
+	return nil
+}
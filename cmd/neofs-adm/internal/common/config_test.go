@@ -0,0 +1,127 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfig(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	dir := t.TempDir()
+	cfgDir := filepath.Join(dir, "conf.d")
+	require.NoError(t, os.Mkdir(cfgDir, 0700))
+
+	cfgFile := filepath.Join(dir, "config.yml")
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`
+rpc-endpoint: http://file:30333
+alphabet-wallets: /wallets
+network:
+  epoch_duration: 240
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "10.yml"), []byte(`
+rpc-endpoint: http://dir:30333
+alphabet-wallets: /wallets
+network:
+  max_object_size: 100
+`), 0600))
+
+	v := viper.New()
+	v.Set(commonflags.ConfigFlag, cfgFile)
+	v.Set(commonflags.ConfigDirFlag, cfgDir)
+
+	conflicts := ReadConfig(v)
+	require.Equal(t, []ConfigConflict{{
+		Key:       "rpc-endpoint",
+		FileValue: "http://file:30333",
+		DirValue:  "http://dir:30333",
+	}}, conflicts)
+
+	require.Equal(t, "http://dir:30333", v.GetString("rpc-endpoint"))
+	require.Equal(t, "/wallets", v.GetString("alphabet-wallets"))
+	require.Equal(t, 240, v.GetInt("network.epoch_duration"))
+	require.Equal(t, 100, v.GetInt("network.max_object_size"))
+
+	require.NoError(t, CheckConfigConflicts(conflicts))
+
+	viper.Set(commonflags.StrictConfig, true)
+	require.Error(t, CheckConfigConflicts(conflicts))
+	require.NoError(t, CheckConfigConflicts(nil))
+}
+
+func TestReadConfig_DefaultPath(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	cwd := t.TempDir()
+	require.NoError(t, os.Chdir(cwd))
+	t.Cleanup(func() { require.NoError(t, os.Chdir(wd)) })
+
+	v := viper.New()
+	ReadConfig(v)
+	require.Empty(t, DefaultConfigFile())
+
+	homeCfg := filepath.Join(home, ".config", "neofs-adm", "config.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(homeCfg), 0700))
+	require.NoError(t, os.WriteFile(homeCfg, []byte("rpc-endpoint: http://home:30333\n"), 0600))
+
+	v = viper.New()
+	ReadConfig(v)
+	require.Equal(t, homeCfg, DefaultConfigFile())
+	require.Equal(t, "http://home:30333", v.GetString("rpc-endpoint"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(cwd, "config.yaml"), []byte("rpc-endpoint: http://cwd:30333\n"), 0600))
+
+	v = viper.New()
+	ReadConfig(v)
+	require.Equal(t, "config.yaml", DefaultConfigFile())
+	require.Equal(t, "http://cwd:30333", v.GetString("rpc-endpoint"))
+
+	explicit := filepath.Join(t.TempDir(), "explicit.yml")
+	require.NoError(t, os.WriteFile(explicit, []byte("rpc-endpoint: http://explicit:30333\n"), 0600))
+
+	v = viper.New()
+	v.Set(commonflags.ConfigFlag, explicit)
+	ReadConfig(v)
+	require.Empty(t, DefaultConfigFile())
+	require.Equal(t, "http://explicit:30333", v.GetString("rpc-endpoint"))
+}
+
+func TestReadConfig_DefaultDir(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	cwd := t.TempDir()
+	require.NoError(t, os.Chdir(cwd))
+	t.Cleanup(func() { require.NoError(t, os.Chdir(wd)) })
+
+	require.NoError(t, os.WriteFile("config.yaml", []byte("rpc-endpoint: file\n"), 0600))
+	require.NoError(t, os.Mkdir("config.d", 0700))
+	require.NoError(t, os.WriteFile(filepath.Join("config.d", "1.yml"), []byte("rpc-endpoint: dir\n"), 0600))
+
+	v := viper.New()
+	ReadConfig(v)
+	require.Equal(t, "config.d", DefaultConfigDir())
+	require.Equal(t, "dir", v.GetString("rpc-endpoint"))
+
+	explicit := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(explicit, "1.yml"), []byte("rpc-endpoint: explicit\n"), 0600))
+
+	v = viper.New()
+	v.Set(commonflags.ConfigDirFlag, explicit)
+	ReadConfig(v)
+	require.Empty(t, DefaultConfigDir())
+	require.Equal(t, "explicit", v.GetString("rpc-endpoint"))
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// ErrAborted is returned when user declines the action.
+var ErrAborted = errors.New("aborted by user")
+
+// Confirm asks user to confirm the action described by prompt and returns
+// ErrAborted if the action is declined. No question is asked if the
+// commonflags.AssumeYes flag is set or the standard input is not a terminal
+// (e.g. in scripts), so non-interactive runs are not broken.
+func Confirm(cmd *cobra.Command, prompt string) error {
+	if viper.GetBool(commonflags.AssumeYes) || !isInteractive(cmd.InOrStdin()) {
+		return nil
+	}
+
+	cmd.Printf("%s [y/N]: ", Colorize(cmd, ColorBold, prompt))
+
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("can't read confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return ErrAborted
+	}
+}
+
+// isInteractive checks whether r is a terminal. Readers other than files
+// (e.g. set by cobra.Command.SetIn) are considered interactive.
+func isInteractive(r io.Reader) bool {
+	f, ok := r.(*os.File)
+	return !ok || term.IsTerminal(int(f.Fd()))
+}
@@ -0,0 +1,55 @@
+package common
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirm(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	confirm := func(input string) error {
+		cmd := new(cobra.Command)
+		cmd.SetIn(strings.NewReader(input))
+		cmd.SetOut(io.Discard)
+		return Confirm(cmd, "Proceed?")
+	}
+
+	require.NoError(t, confirm("y\n"))
+	require.NoError(t, confirm("YES"))
+	require.ErrorIs(t, confirm("n\n"), ErrAborted)
+	require.ErrorIs(t, confirm(""), ErrAborted)
+
+	// dry run doesn't skip the confirmation
+	viper.Set(commonflags.DryRun, true)
+	require.ErrorIs(t, confirm("n\n"), ErrAborted)
+
+	t.Run("non-interactive", func(t *testing.T) {
+		f, err := os.Open(os.DevNull)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = f.Close() })
+
+		cmd := new(cobra.Command)
+		cmd.SetIn(f)
+		out := bytes.NewBuffer(nil)
+		cmd.SetOut(out)
+		require.NoError(t, Confirm(cmd, "Proceed?"))
+		require.Empty(t, out.String())
+	})
+
+	viper.Set(commonflags.AssumeYes, true)
+
+	cmd := new(cobra.Command)
+	out := bytes.NewBuffer(nil)
+	cmd.SetOut(out)
+	require.NoError(t, Confirm(cmd, "Proceed?"))
+	require.Empty(t, out.String())
+}
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// CommandContext returns the command context bounded by the
+// commonflags.Timeout value. Zero timeout means no deadline.
+func CommandContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timeout := viper.GetDuration(commonflags.Timeout)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var log = zap.NewNop()
+
+// Logger returns logger configured by InitLogger. Before
+// the initialization no-op logger is returned.
+func Logger() *zap.Logger {
+	return log
+}
+
+// VerbosityLevel returns the number of times commonflags.Verbose flag
+// is specified.
+func VerbosityLevel() int {
+	return viper.GetInt(commonflags.Verbose)
+}
+
+// InitLogger builds the application logger according to the
+// commonflags.LogLevel, commonflags.Verbose and commonflags.Quiet
+// values. Verbose and quiet flags have priority and set "debug" and
+// "error" levels respectively. Verbosity level 2 and higher also adds
+// stack traces to warnings and errors.
+func InitLogger() error {
+	verbosity := VerbosityLevel()
+
+	lvl := viper.GetString(commonflags.LogLevel)
+	if verbosity > 0 {
+		lvl = "debug"
+	} else if viper.GetBool(commonflags.Quiet) {
+		lvl = "error"
+	}
+
+	var prm logger.Prm
+
+	err := prm.SetLevelString(lvl)
+	if err != nil {
+		return fmt.Errorf("invalid log level '%s': %w", lvl, err)
+	}
+
+	l, err := logger.NewLogger(&prm)
+	if err != nil {
+		return fmt.Errorf("can't build logger: %w", err)
+	}
+
+	log = l.Logger
+	if verbosity > 1 {
+		log = log.WithOptions(zap.AddStacktrace(zapcore.WarnLevel))
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package common
+
+import (
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// NetworkMagic returns network magic set via commonflags.NetworkMagic
+// flag. Returns false if the magic is not set.
+func NetworkMagic() (netmode.Magic, bool) {
+	m := viper.GetUint32(commonflags.NetworkMagic)
+	return netmode.Magic(m), m != 0
+}
+
+// CheckNetworkMagic logs a warning if network magic set via
+// commonflags.NetworkMagic flag differs from the actual one.
+// Returns false in this case.
+func CheckNetworkMagic(actual netmode.Magic) bool {
+	expected, ok := NetworkMagic()
+	if !ok || expected == actual {
+		return true
+	}
+
+	Logger().Warn("network magic mismatch",
+		zap.Uint32("flag", uint32(expected)),
+		zap.Uint32("node", uint32(actual)),
+	)
+
+	return false
+}
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckNetworkMagic(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	_, ok := NetworkMagic()
+	require.False(t, ok)
+	require.True(t, CheckNetworkMagic(netmode.MainNet))
+
+	viper.Set(commonflags.NetworkMagic, uint32(netmode.TestNet))
+
+	m, ok := NetworkMagic()
+	require.True(t, ok)
+	require.Equal(t, netmode.TestNet, m)
+	require.True(t, CheckNetworkMagic(netmode.TestNet))
+	require.False(t, CheckNetworkMagic(netmode.MainNet))
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Supported values of the commonflags.OutputFormat flag.
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
+
+// CheckOutputFormat returns an error if commonflags.OutputFormat
+// value is not supported.
+func CheckOutputFormat() error {
+	switch f := viper.GetString(commonflags.OutputFormat); f {
+	case OutputText, OutputJSON:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format '%s', must be one of %s, %s", f, OutputText, OutputJSON)
+	}
+}
+
+// JSONOutput checks whether JSON output format was requested.
+func JSONOutput() bool {
+	return viper.GetString(commonflags.OutputFormat) == OutputJSON
+}
+
+// PrintResult prints the command result according to the requested output
+// format: v is encoded as an indented JSON in JSON mode, printText is called
+// otherwise.
+func PrintResult(cmd *cobra.Command, v interface{}, printText func()) error {
+	if !JSONOutput() {
+		printText()
+		return nil
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't encode result to JSON: %w", err)
+	}
+
+	cmd.Println(string(data))
+
+	return nil
+}
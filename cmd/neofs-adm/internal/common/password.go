@@ -0,0 +1,32 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/cli/input"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+)
+
+// ReadWalletPassword returns the wallet password. It is taken from
+// commonflags.WalletPassword flag or environment variable, then from
+// the file set by commonflags.WalletPasswordFile flag. If none is set,
+// the password is read interactively with the provided prompt.
+func ReadWalletPassword(prompt string) (string, error) {
+	if pass := viper.GetString(commonflags.WalletPassword); pass != "" {
+		return pass, nil
+	}
+
+	if p := viper.GetString(commonflags.WalletPasswordFile); p != "" {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return "", fmt.Errorf("can't read password file: %w", err)
+		}
+
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	return input.ReadPassword(prompt)
+}
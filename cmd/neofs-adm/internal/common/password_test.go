@@ -0,0 +1,36 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWalletPassword(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	p := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(p, []byte("secret\n"), 0600))
+
+	viper.Set(commonflags.WalletPasswordFile, p)
+
+	pass, err := ReadWalletPassword("")
+	require.NoError(t, err)
+	require.Equal(t, "secret", pass)
+
+	viper.Set(commonflags.WalletPassword, "flag")
+
+	pass, err = ReadWalletPassword("")
+	require.NoError(t, err)
+	require.Equal(t, "flag", pass)
+
+	viper.Set(commonflags.WalletPassword, "")
+	viper.Set(commonflags.WalletPasswordFile, filepath.Join(t.TempDir(), "missing"))
+
+	_, err = ReadWalletPassword("")
+	require.Error(t, err)
+}
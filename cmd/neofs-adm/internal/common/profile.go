@@ -0,0 +1,32 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+)
+
+const profilesSection = "profiles"
+
+// ApplyProfile merges `profiles.<name>` config section selected by the
+// commonflags.Profile value on top of the config. Values set by flags and
+// environment variables still take precedence. Does nothing if no
+// profile is selected.
+func ApplyProfile(v *viper.Viper) error {
+	name := v.GetString(commonflags.Profile)
+	if name == "" {
+		return nil
+	}
+
+	key := profilesSection + "." + name
+	if !v.IsSet(key) {
+		return fmt.Errorf("config profile '%s' not found", name)
+	}
+
+	if err := v.MergeConfigMap(v.GetStringMap(key)); err != nil {
+		return fmt.Errorf("can't apply config profile '%s': %w", name, err)
+	}
+
+	return nil
+}
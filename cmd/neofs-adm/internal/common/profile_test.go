@@ -0,0 +1,50 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const profileConfig = `
+rpc-endpoint: http://mainnet:30333
+network:
+  epoch_duration: 240
+  max_object_size: 100
+profiles:
+  dev:
+    rpc-endpoint: http://localhost:30333
+    network:
+      epoch_duration: 10
+`
+
+func TestApplyProfile(t *testing.T) {
+	newViper := func(profile string) *viper.Viper {
+		v := viper.New()
+		v.SetConfigType("yml")
+		require.NoError(t, v.ReadConfig(strings.NewReader(profileConfig)))
+		v.Set(commonflags.Profile, profile)
+		return v
+	}
+
+	t.Run("no profile", func(t *testing.T) {
+		v := newViper("")
+		require.NoError(t, ApplyProfile(v))
+		require.Equal(t, "http://mainnet:30333", v.GetString("rpc-endpoint"))
+	})
+
+	t.Run("existing profile", func(t *testing.T) {
+		v := newViper("dev")
+		require.NoError(t, ApplyProfile(v))
+		require.Equal(t, "http://localhost:30333", v.GetString("rpc-endpoint"))
+		require.Equal(t, 10, v.GetInt("network.epoch_duration"))
+		require.Equal(t, 100, v.GetInt("network.max_object_size"))
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		require.Error(t, ApplyProfile(newViper("test")))
+	})
+}
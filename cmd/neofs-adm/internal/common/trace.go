@@ -0,0 +1,73 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+)
+
+// Span describes a single traced RPC call.
+type Span struct {
+	Name     string
+	Start    time.Time
+	Duration time.Duration
+	Err      error
+}
+
+var traced struct {
+	mtx   sync.Mutex
+	spans []Span
+}
+
+// TraceEnabled checks whether RPC call tracing is requested
+// via commonflags.Trace flag.
+func TraceEnabled() bool {
+	return viper.GetBool(commonflags.Trace)
+}
+
+// TraceSpan records the RPC call started at the specified time and
+// finished now with the given error. Does nothing if tracing is disabled.
+func TraceSpan(name string, start time.Time, err error) {
+	if !TraceEnabled() {
+		return
+	}
+
+	traced.mtx.Lock()
+	traced.spans = append(traced.spans, Span{
+		Name:     name,
+		Start:    start,
+		Duration: time.Since(start),
+		Err:      err,
+	})
+	traced.mtx.Unlock()
+}
+
+// PrintTrace writes timings of all RPC calls recorded via TraceSpan to w.
+// Does nothing if tracing is disabled.
+func PrintTrace(w io.Writer) {
+	if !TraceEnabled() {
+		return
+	}
+
+	traced.mtx.Lock()
+	defer traced.mtx.Unlock()
+
+	var total time.Duration
+
+	fmt.Fprintf(w, "RPC trace (%d calls):\n", len(traced.spans))
+	for i := range traced.spans {
+		s := traced.spans[i]
+		total += s.Duration
+
+		fmt.Fprintf(w, "\t%s\t%s\t%v", s.Start.Format("15:04:05.000"), s.Name, s.Duration)
+		if s.Err != nil {
+			fmt.Fprintf(w, "\terror: %v", s.Err)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "Total RPC time: %v\n", total)
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrace(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	buf := new(bytes.Buffer)
+
+	TraceSpan("GetVersion", time.Now(), nil)
+	PrintTrace(buf)
+	require.Empty(t, buf.String(), "tracing is disabled")
+
+	viper.Set(commonflags.Trace, true)
+
+	TraceSpan("GetVersion", time.Now(), nil)
+	TraceSpan("InvokeFunction", time.Now(), errors.New("some error"))
+	PrintTrace(buf)
+
+	require.Contains(t, buf.String(), "RPC trace (2 calls)")
+	require.Contains(t, buf.String(), "GetVersion")
+	require.Contains(t, buf.String(), "InvokeFunction")
+	require.Contains(t, buf.String(), "error: some error")
+	require.Contains(t, buf.String(), "Total RPC time")
+}
@@ -0,0 +1,15 @@
+package common
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// PrintInfo prints informational message to the command output
+// unless the commonflags.Quiet flag is on.
+func PrintInfo(cmd *cobra.Command, format string, a ...interface{}) {
+	if !viper.GetBool(commonflags.Quiet) {
+		cmd.Printf(format+"\n", a...)
+	}
+}
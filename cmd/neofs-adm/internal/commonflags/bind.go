@@ -0,0 +1,58 @@
+package commonflags
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// EnvPrefix is a prefix of the environment variables corresponding to the flags.
+const EnvPrefix = "NEOFS_ADM"
+
+// EnvName returns the name of the environment variable corresponding to the
+// flag key, e.g. NEOFS_ADM_RPC_ENDPOINT for "rpc-endpoint".
+func EnvName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(key))
+}
+
+// Bind binds the flag and the corresponding environment variable (see EnvName)
+// to the flag key in v. Values are resolved in the following order:
+//   - flag set in the command line;
+//   - environment variable;
+//   - config directory;
+//   - config file;
+//   - flag default value.
+//
+// Config file and directory are expected to be merged in v with the directory
+// values on top.
+func Bind(v *viper.Viper, f *pflag.Flag) error {
+	if err := v.BindPFlag(f.Name, f); err != nil {
+		return err
+	}
+
+	return v.BindEnv(f.Name, EnvName(f.Name))
+}
+
+// BindAll binds all the flags of the set, see Bind.
+func BindAll(v *viper.Viper, fs *pflag.FlagSet) {
+	fs.VisitAll(func(f *pflag.Flag) {
+		_ = Bind(v, f)
+	})
+}
+
+// InitConfigFlags defines ConfigFlag and ConfigDirFlag in fs and binds them
+// to v, see Bind. Config sources are applied in the following order, each
+// next one overriding the previous:
+//   - config file (ConfigFlag);
+//   - files of the config directory (ConfigDirFlag) in lexicographical order.
+//
+// Both flags must be defined through this function so that every command
+// reads the configuration in the same way.
+func InitConfigFlags(v *viper.Viper, fs *pflag.FlagSet) {
+	fs.StringP(ConfigFlag, ConfigFlagShorthand, "", ConfigFlagUsage)
+	fs.StringP(ConfigDirFlag, ConfigDirFlagShorthand, "", ConfigDirFlagUsage)
+
+	_ = Bind(v, fs.Lookup(ConfigFlag))
+	_ = Bind(v, fs.Lookup(ConfigDirFlag))
+}
@@ -0,0 +1,70 @@
+package commonflags_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvName(t *testing.T) {
+	require.Equal(t, commonflags.ConfigEnv, commonflags.EnvName(commonflags.ConfigFlag))
+	require.Equal(t, commonflags.ConfigDirEnv, commonflags.EnvName(commonflags.ConfigDirFlag))
+	require.Equal(t, commonflags.WalletPasswordEnv, commonflags.EnvName(commonflags.WalletPassword))
+}
+
+func TestBindPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	cfgDir := filepath.Join(dir, "conf.d")
+	require.NoError(t, os.Mkdir(cfgDir, 0700))
+
+	cfgFile := filepath.Join(dir, "config.yml")
+	require.NoError(t, os.WriteFile(cfgFile, []byte("rpc-endpoint: file\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "1.yml"), []byte("rpc-endpoint: dir\n"), 0600))
+
+	load := func(args ...string) string {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.String(commonflags.ConfigFlag, "", "")
+		fs.String(commonflags.ConfigDirFlag, "", "")
+		fs.String(commonflags.EndpointFlag, "default", "")
+		require.NoError(t, fs.Parse(args))
+
+		v := viper.New()
+		commonflags.BindAll(v, fs)
+		common.ReadConfig(v)
+
+		return v.GetString(commonflags.EndpointFlag)
+	}
+
+	require.Equal(t, "default", load())
+	require.Equal(t, "file", load("--config", cfgFile))
+	require.Equal(t, "dir", load("--config", cfgFile, "--config-dir", cfgDir))
+
+	t.Setenv(commonflags.EnvName(commonflags.EndpointFlag), "env")
+	require.Equal(t, "env", load("--config", cfgFile, "--config-dir", cfgDir))
+	require.Equal(t, "flag", load("--config", cfgFile, "--config-dir", cfgDir, "--rpc-endpoint", "flag"))
+}
+
+func TestInitConfigFlags(t *testing.T) {
+	dir := t.TempDir()
+	cfgDir := filepath.Join(dir, "conf.d")
+	require.NoError(t, os.Mkdir(cfgDir, 0700))
+
+	cfgFile := filepath.Join(dir, "config.yml")
+	require.NoError(t, os.WriteFile(cfgFile, []byte("rpc-endpoint: file\nalphabet-wallets: /file\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "1.yml"), []byte("rpc-endpoint: dir\n"), 0600))
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	v := viper.New()
+	commonflags.InitConfigFlags(v, fs)
+	require.NoError(t, fs.Parse([]string{"-c", cfgFile, "-d", cfgDir}))
+
+	common.ReadConfig(v)
+	require.Equal(t, "dir", v.GetString(commonflags.EndpointFlag))
+	require.Equal(t, "/file", v.GetString("alphabet-wallets"))
+}
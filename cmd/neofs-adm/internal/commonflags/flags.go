@@ -0,0 +1,101 @@
+package commonflags
+
+import "time"
+
+// Common neofs-adm flag keys, shorthands, default
+// values and their usage descriptions.
+const (
+	// ConfigFlag and ConfigDirFlag can also be set via ConfigEnv and
+	// ConfigDirEnv environment variables respectively. Flags take
+	// precedence over environment variables.
+	ConfigFlag          = "config"
+	ConfigFlagShorthand = "c"
+	ConfigFlagUsage     = "Config file (env " + ConfigEnv + ")"
+	ConfigEnv           = "NEOFS_ADM_CONFIG"
+
+	ConfigDirFlag          = "config-dir"
+	ConfigDirFlagShorthand = "d"
+	ConfigDirFlagUsage     = "Config directory, its files are merged on top of the config file (env " + ConfigDirEnv + ")"
+	ConfigDirEnv           = "NEOFS_ADM_CONFIG_DIR"
+
+	StrictConfig      = "strict-config"
+	StrictConfigUsage = "Fail if config file and config directory set the same key to different values"
+
+	// Profile selects `profiles.<name>` config section which overrides
+	// values of the config file and config directory.
+	Profile      = "profile"
+	ProfileUsage = "Name of the config profile to apply on top of the config"
+
+	Verbose          = "verbose"
+	VerboseShorthand = "v"
+	VerboseUsage     = "Verbose output: -v sets debug log level, -vv also adds stack traces to warnings and errors"
+
+	Quiet          = "quiet"
+	QuietShorthand = "q"
+	QuietUsage     = "Print only errors and requested results"
+
+	LogLevel        = "log-level"
+	LogLevelDefault = "info"
+	LogLevelUsage   = `Logging level: one of "debug", "info", "warn", "error"`
+
+	Timeout          = "timeout"
+	TimeoutShorthand = "t"
+	TimeoutDefault   = time.Minute
+	TimeoutUsage     = "Timeout for an operation awaiting chain, 0 means no timeout"
+
+	// EndpointFlag is a command-line counterpart of the `rpc-endpoint`
+	// config key. The value set via the flag takes precedence over the
+	// value from the config file.
+	EndpointFlag          = "rpc-endpoint"
+	EndpointFlagShorthand = "r"
+	EndpointFlagUsage     = "N3 RPC node endpoint"
+
+	// NetworkMagic allows to sign transactions without a live node. If
+	// the node is available, its network magic is checked against the
+	// flag value.
+	NetworkMagic      = "network-magic"
+	NetworkMagicUsage = "N3 network magic, checked against the RPC node one if it is available"
+
+	// WalletPath is a command-line counterpart of the `wallet` config key.
+	// The value set via the flag takes precedence over the value from the
+	// config file.
+	WalletPath          = "wallet"
+	WalletPathShorthand = "w"
+	WalletPathUsage     = "Path to the wallet"
+
+	// WalletPassword can also be set via the WalletPasswordEnv environment
+	// variable. Prefer WalletPasswordFile or the environment variable since
+	// the flag value may be saved in the shell history.
+	WalletPassword      = "wallet-password"
+	WalletPasswordUsage = "Password of the wallet (insecure, see --" + WalletPasswordFile + ")"
+	WalletPasswordEnv   = "NEOFS_ADM_WALLET_PASSWORD"
+
+	WalletPasswordFile      = "wallet-password-file"
+	WalletPasswordFileUsage = "Path to the file with the wallet password"
+
+	OutputFormat        = "output-format"
+	OutputFormatDefault = "text"
+	OutputFormatUsage   = `Output format: one of "text", "json"`
+
+	// Color controls ANSI colors in the output. In "auto" mode the output
+	// is colored only if it is a terminal and NO_COLOR environment variable
+	// is not set.
+	Color        = "color"
+	ColorDefault = "auto"
+	ColorUsage   = `Colored output: one of "auto", "always", "never"`
+
+	NoColor      = "no-color"
+	NoColorUsage = "Disable colored output, same as --" + Color + "=never"
+
+	// Trace enables timing of N3 RPC calls, collected timings are printed
+	// to stderr when the command finishes.
+	Trace      = "trace"
+	TraceUsage = "Print timings of N3 RPC calls to stderr when the command finishes"
+
+	AssumeYes          = "yes"
+	AssumeYesShorthand = "y"
+	AssumeYesUsage     = "Assume 'yes' answer to all confirmation prompts"
+
+	DryRun      = "dry-run"
+	DryRunUsage = "Print transactions instead of sending them (honored by commands sending committee transactions, other commands fail instead of sending)"
+)
@@ -16,6 +16,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -47,6 +48,7 @@ func dumpNetworkConfig(cmd *cobra.Command, _ []string) error {
 
 	buf := bytes.NewBuffer(nil)
 	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+	res := make(map[string]interface{}, len(arr))
 
 	for _, param := range arr {
 		tuple, ok := param.Value().([]stackitem.Item)
@@ -73,8 +75,10 @@ func dumpNetworkConfig(cmd *cobra.Command, _ []string) error {
 			nbuf := make([]byte, 8)
 			copy(nbuf[:], v)
 			n := binary.LittleEndian.Uint64(nbuf)
+			res[string(k)] = n
 			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%d (int)\n", k, n)))
 		case netmapEigenTrustAlphaKey:
+			res[string(k)] = string(v)
 			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%s (str)\n", k, v)))
 		case netmapHomomorphicHashDisabledKey, netmapMaintenanceAllowedKey:
 			vBool, err := tuple[1].TryBool()
@@ -82,16 +86,19 @@ func dumpNetworkConfig(cmd *cobra.Command, _ []string) error {
 				return invalidConfigValueErr(k)
 			}
 
+			res[string(k)] = vBool
 			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%t (bool)\n", k, vBool)))
 		default:
+			res[string(k)] = hex.EncodeToString(v)
 			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%s (hex)\n", k, hex.EncodeToString(v))))
 		}
 	}
 
 	_ = tw.Flush()
-	cmd.Print(buf.String())
 
-	return nil
+	return common.PrintResult(cmd, res, func() {
+		cmd.Print(buf.String())
+	})
 }
 
 func setConfigCmd(cmd *cobra.Command, args []string) error {
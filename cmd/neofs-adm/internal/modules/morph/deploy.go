@@ -16,6 +16,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
 	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
 	"github.com/nspcc-dev/neofs-contract/nns"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -38,7 +39,7 @@ Contract's manifest file name must be 'config.json'.
 NNS name is taken by stripping '_contract.nef' from the NEF file (similar to neofs contracts).`,
 	PreRun: func(cmd *cobra.Command, _ []string) {
 		_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-		_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 	},
 	RunE: deployContractCmd,
 }
@@ -49,7 +50,7 @@ func init() {
 	ff.String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
 	_ = deployCmd.MarkFlagFilename(alphabetWalletsFlag)
 
-	ff.StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	ff.StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	ff.String(contractPathFlag, "", "Path to the contract directory")
 	_ = deployCmd.MarkFlagFilename(contractPathFlag)
 
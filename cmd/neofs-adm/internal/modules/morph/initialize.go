@@ -16,6 +16,8 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/config"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring"
 	morphClient "github.com/nspcc-dev/neofs-node/pkg/morph/client"
@@ -53,44 +55,44 @@ func initializeSideChainCmd(cmd *cobra.Command, args []string) error {
 	defer initCtx.close()
 
 	// 1. Transfer funds to committee accounts.
-	cmd.Println("Stage 1: transfer GAS to alphabet nodes.")
+	common.PrintInfo(cmd, "Stage 1: transfer GAS to alphabet nodes.")
 	if err := initCtx.transferFunds(); err != nil {
 		return err
 	}
 
-	cmd.Println("Stage 2: set notary and alphabet nodes in designate contract.")
+	common.PrintInfo(cmd, "Stage 2: set notary and alphabet nodes in designate contract.")
 	if err := initCtx.setNotaryAndAlphabetNodes(); err != nil {
 		return err
 	}
 
 	// 3. Deploy NNS contract.
-	cmd.Println("Stage 3: deploy NNS contract.")
+	common.PrintInfo(cmd, "Stage 3: deploy NNS contract.")
 	if err := initCtx.deployNNS(deployMethodName); err != nil {
 		return err
 	}
 
 	// 4. Deploy NeoFS contracts.
-	cmd.Println("Stage 4: deploy NeoFS contracts.")
+	common.PrintInfo(cmd, "Stage 4: deploy NeoFS contracts.")
 	if err := initCtx.deployContracts(); err != nil {
 		return err
 	}
 
-	cmd.Println("Stage 4.1: Transfer GAS to proxy contract.")
+	common.PrintInfo(cmd, "Stage 4.1: Transfer GAS to proxy contract.")
 	if err := initCtx.transferGASToProxy(); err != nil {
 		return err
 	}
 
-	cmd.Println("Stage 5: register candidates.")
+	common.PrintInfo(cmd, "Stage 5: register candidates.")
 	if err := initCtx.registerCandidates(); err != nil {
 		return err
 	}
 
-	cmd.Println("Stage 6: transfer NEO to alphabet contracts.")
+	common.PrintInfo(cmd, "Stage 6: transfer NEO to alphabet contracts.")
 	if err := initCtx.transferNEOToAlphabetContracts(); err != nil {
 		return err
 	}
 
-	cmd.Println("Stage 7: set addresses in NNS.")
+	common.PrintInfo(cmd, "Stage 7: set addresses in NNS.")
 	if err := initCtx.setNNS(); err != nil {
 		return err
 	}
@@ -125,10 +127,14 @@ func newInitializeContext(cmd *cobra.Command, v *viper.Viper) (*initializeContex
 
 	var c Client
 	if v.GetString(localDumpFlag) != "" {
-		if v.GetString(endpointFlag) != "" {
-			return nil, fmt.Errorf("`%s` and `%s` flags are mutually exclusive", endpointFlag, localDumpFlag)
+		if v.GetString(commonflags.EndpointFlag) != "" {
+			return nil, fmt.Errorf("`%s` and `%s` flags are mutually exclusive", commonflags.EndpointFlag, localDumpFlag)
 		}
 		c, err = newLocalClient(cmd, v, wallets)
+		if err == nil {
+			magic, _ := c.GetNetwork()
+			common.CheckNetworkMagic(magic)
+		}
 	} else {
 		c, err = getN3Client(v)
 	}
@@ -322,10 +328,13 @@ func (c *clientContext) awaitTx(cmd *cobra.Command) error {
 }
 
 func awaitTx(cmd *cobra.Command, c Client, txs []hashVUBPair) error {
-	cmd.Println("Waiting for transactions to persist...")
+	common.PrintInfo(cmd, "Waiting for transactions to persist...")
 
 	const pollInterval = time.Second
 
+	ctx, cancel := common.CommandContext(cmd)
+	defer cancel()
+
 	tick := time.NewTicker(pollInterval)
 	defer tick.Stop()
 
@@ -351,7 +360,13 @@ loop:
 		if txs[i].vub < currBlock {
 			return fmt.Errorf("tx was not persisted: vub=%d, height=%d", txs[i].vub, currBlock)
 		}
-		for range tick.C {
+		for {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("tx was not persisted: %w", ctx.Err())
+			case <-tick.C:
+			}
+
 			// We must fetch current height before application log, to avoid race condition.
 			currBlock, err = c.GetBlockCount()
 			if err != nil {
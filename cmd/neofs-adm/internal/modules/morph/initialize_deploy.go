@@ -27,8 +27,9 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
 	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
 	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
-	"github.com/nspcc-dev/neofs-contract/common"
+	contractcommon "github.com/nspcc-dev/neofs-contract/common"
 	"github.com/nspcc-dev/neofs-contract/nns"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring"
 	morphClient "github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	"github.com/spf13/viper"
@@ -108,9 +109,9 @@ func (c *initializeContext) deployNNS(method string) error {
 	if err == nil {
 		if nnsCs.NEF.Checksum == cs.NEF.Checksum {
 			if method == deployMethodName {
-				c.Command.Println("NNS contract is already deployed.")
+				common.PrintInfo(c.Command, "NNS contract is already deployed.")
 			} else {
-				c.Command.Println("NNS contract is already updated.")
+				common.PrintInfo(c.Command, "NNS contract is already updated.")
 			}
 			return nil
 		}
@@ -210,10 +211,10 @@ func (c *initializeContext) updateContracts() error {
 	}
 
 	if err := c.sendCommitteeTx(w.Bytes(), false); err != nil {
-		if !strings.Contains(err.Error(), common.ErrAlreadyUpdated) {
+		if !strings.Contains(err.Error(), contractcommon.ErrAlreadyUpdated) {
 			return err
 		}
-		c.Command.Println("Alphabet contracts are already updated.")
+		common.PrintInfo(c.Command, "Alphabet contracts are already updated.")
 	}
 
 	w.Reset()
@@ -250,10 +251,10 @@ func (c *initializeContext) updateContracts() error {
 		params := getContractDeployParameters(cs, c.getContractDeployData(ctrName, keysParam))
 		res, err := c.CommitteeAct.MakeCall(invokeHash, method, params...)
 		if err != nil {
-			if method != updateMethodName || !strings.Contains(err.Error(), common.ErrAlreadyUpdated) {
+			if method != updateMethodName || !strings.Contains(err.Error(), contractcommon.ErrAlreadyUpdated) {
 				return fmt.Errorf("deploy contract: %w", err)
 			}
-			c.Command.Printf("%s contract is already updated.\n", ctrName)
+			common.PrintInfo(c.Command, "%s contract is already updated.", ctrName)
 			continue
 		}
 
@@ -274,7 +275,7 @@ func (c *initializeContext) updateContracts() error {
 				emit.AppCall(w.BinWriter, nnsHash, "addRecord", callflag.All,
 					domain, int64(nns.TXT), address.Uint160ToString(cs.Hash))
 			}
-			c.Command.Printf("NNS: Set %s -> %s\n", domain, cs.Hash.StringLE())
+			common.PrintInfo(c.Command, "NNS: Set %s -> %s", domain, cs.Hash.StringLE())
 		}
 	}
 
@@ -283,7 +284,7 @@ func (c *initializeContext) updateContracts() error {
 	if err != nil {
 		return err
 	}
-	c.Command.Printf("NNS: Set %s -> %s\n", morphClient.NNSGroupKeyName, hex.EncodeToString(groupKey.Bytes()))
+	common.PrintInfo(c.Command, "NNS: Set %s -> %s", morphClient.NNSGroupKeyName, hex.EncodeToString(groupKey.Bytes()))
 
 	emit.Opcodes(w.BinWriter, opcode.LDSFLD0)
 	emit.Int(w.BinWriter, 1)
@@ -307,7 +308,7 @@ func (c *initializeContext) deployContracts() error {
 	for i, acc := range c.Accounts {
 		ctrHash := state.CreateContractHash(acc.Contract.ScriptHash(), alphaCs.NEF.Checksum, alphaCs.Manifest.Name)
 		if c.isUpdated(ctrHash, alphaCs) {
-			c.Command.Printf("Alphabet contract #%d is already deployed.\n", i)
+			common.PrintInfo(c.Command, "Alphabet contract #%d is already deployed.", i)
 			continue
 		}
 
@@ -325,12 +326,14 @@ func (c *initializeContext) deployContracts() error {
 			return fmt.Errorf("could not create actor: %w", err)
 		}
 
-		txHash, vub, err := act.SendCall(management.Hash, deployMethodName, params...)
+		tx, err := act.MakeCall(management.Hash, deployMethodName, params...)
 		if err != nil {
 			return fmt.Errorf("can't deploy alphabet #%d contract: %w", i, err)
 		}
 
-		c.SentTxs = append(c.SentTxs, hashVUBPair{hash: txHash, vub: vub})
+		if err := c.sendTx(tx, c.Command, false); err != nil {
+			return fmt.Errorf("can't deploy alphabet #%d contract: %w", i, err)
+		}
 	}
 
 	for _, ctrName := range contractList {
@@ -338,7 +341,7 @@ func (c *initializeContext) deployContracts() error {
 
 		ctrHash := cs.Hash
 		if c.isUpdated(ctrHash, cs) {
-			c.Command.Printf("%s contract is already deployed.\n", ctrName)
+			common.PrintInfo(c.Command, "%s contract is already deployed.", ctrName)
 			continue
 		}
 
@@ -393,7 +396,7 @@ func (c *initializeContext) readContracts(names []string) error {
 	} else {
 		var r io.ReadCloser
 		if c.ContractPath == "" {
-			c.Command.Println("Contracts flag is missing, latest release will be fetched from Github.")
+			common.PrintInfo(c.Command, "Contracts flag is missing, latest release will be fetched from Github.")
 			r, err = downloadContractsFromGithub(c.Command)
 		} else {
 			r, err = os.Open(c.ContractPath)
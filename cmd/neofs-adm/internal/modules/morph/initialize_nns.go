@@ -21,6 +21,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
 	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
 	"github.com/nspcc-dev/neofs-contract/nns"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 	morphClient "github.com/nspcc-dev/neofs-node/pkg/morph/client"
 )
 
@@ -57,7 +58,7 @@ func (c *initializeContext) setNNS() error {
 		if err := c.nnsRegisterDomain(nnsCs.Hash, alphaCs.Hash, domain); err != nil {
 			return err
 		}
-		c.Command.Printf("NNS: Set %s -> %s\n", domain, alphaCs.Hash.StringLE())
+		common.PrintInfo(c.Command, "NNS: Set %s -> %s", domain, alphaCs.Hash.StringLE())
 	}
 
 	for _, ctrName := range contractList {
@@ -67,7 +68,7 @@ func (c *initializeContext) setNNS() error {
 		if err := c.nnsRegisterDomain(nnsCs.Hash, cs.Hash, domain); err != nil {
 			return err
 		}
-		c.Command.Printf("NNS: Set %s -> %s\n", domain, cs.Hash.StringLE())
+		common.PrintInfo(c.Command, "NNS: Set %s -> %s", domain, cs.Hash.StringLE())
 	}
 
 	groupKey := c.ContractWallet.Accounts[0].PrivateKey().PublicKey()
@@ -75,7 +76,7 @@ func (c *initializeContext) setNNS() error {
 	if err != nil {
 		return err
 	}
-	c.Command.Printf("NNS: Set %s -> %s\n", morphClient.NNSGroupKeyName, hex.EncodeToString(groupKey.Bytes()))
+	common.PrintInfo(c.Command, "NNS: Set %s -> %s", morphClient.NNSGroupKeyName, hex.EncodeToString(groupKey.Bytes()))
 
 	return c.awaitTx()
 }
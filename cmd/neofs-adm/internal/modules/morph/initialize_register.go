@@ -15,6 +15,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
 	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 )
 
 // initialAlphabetNEOAmount represents the total amount of GAS distributed between alphabet nodes.
@@ -29,7 +30,7 @@ func (c *initializeContext) registerCandidates() error {
 	}
 
 	if len(cc) > 0 {
-		c.Command.Println("Candidates are already registered.")
+		common.PrintInfo(c.Command, "Candidates are already registered.")
 		return nil
 	}
 
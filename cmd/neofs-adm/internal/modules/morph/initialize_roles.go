@@ -6,12 +6,13 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/rolemgmt"
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 )
 
 func (c *initializeContext) setNotaryAndAlphabetNodes() error {
 	if ok, err := c.setRolesFinished(); ok || err != nil {
 		if err == nil {
-			c.Command.Println("Stage 2: already performed.")
+			common.PrintInfo(c.Command, "Stage 2: already performed.")
 		}
 		return err
 	}
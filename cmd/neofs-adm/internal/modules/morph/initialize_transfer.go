@@ -14,6 +14,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
 	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 )
 
 const (
@@ -28,7 +29,7 @@ func (c *initializeContext) transferFunds() error {
 	ok, err := c.transferFundsFinished()
 	if ok || err != nil {
 		if err == nil {
-			c.Command.Println("Stage 1: already performed.")
+			common.PrintInfo(c.Command, "Stage 1: already performed.")
 		}
 		return err
 	}
@@ -2,6 +2,7 @@ package morph
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"time"
@@ -19,8 +20,11 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.uber.org/zap"
 )
 
 // Client represents N3 client interface capable of test-invoking scripts
@@ -67,10 +71,13 @@ func getN3Client(v *viper.Viper) (Client, error) {
 	)
 
 	ctx := context.Background()
-	endpoint := v.GetString(endpointFlag)
+	endpoint := v.GetString(commonflags.EndpointFlag)
 	if endpoint == "" {
 		return nil, errors.New("missing endpoint")
 	}
+
+	common.Logger().Debug("connecting to N3 RPC node", zap.String("endpoint", endpoint))
+
 	c, err := rpcclient.New(ctx, endpoint, rpcclient.Options{
 		MaxConnsPerHost: maxConnsPerHost,
 		RequestTimeout:  requestTimeout,
@@ -78,10 +85,49 @@ func getN3Client(v *viper.Viper) (Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := c.Init(); err != nil {
+
+	start := time.Now()
+	err = c.Init()
+	common.TraceSpan("Init", start, err)
+	if err != nil {
 		return nil, err
 	}
-	return c, nil
+
+	if magic, err := c.GetNetwork(); err == nil {
+		common.CheckNetworkMagic(magic)
+	}
+
+	var res Client = c
+	if common.TraceEnabled() {
+		res = tracingClient{res}
+	}
+	if v.GetBool(commonflags.DryRun) {
+		res = dryRunClient{res}
+	}
+	return res, nil
+}
+
+// errDryRun is returned on the attempt to send a transaction not
+// supporting dry-run mode (see commonflags.DryRun).
+var errDryRun = errors.New("transaction can't be sent in dry-run mode")
+
+// dryRunClient is a Client wrapper refusing to send any transaction. It is a
+// safety net for the commands not supporting dry-run mode: transactions
+// supporting it are printed by clientContext.sendTx and never reach Client.
+type dryRunClient struct {
+	Client
+}
+
+func (dryRunClient) SendRawTransaction(*transaction.Transaction) (util.Uint256, error) {
+	return util.Uint256{}, errDryRun
+}
+
+func (dryRunClient) SignAndPushInvocationTx([]byte, *wallet.Account, int64, fixedn.Fixed8, []rpcclient.SignerAccount) (util.Uint256, error) {
+	return util.Uint256{}, errDryRun
+}
+
+func (dryRunClient) SignAndPushP2PNotaryRequest(*transaction.Transaction, []byte, int64, int64, uint32, *wallet.Account) (*payload.P2PNotaryRequest, error) {
+	return nil, errDryRun
 }
 
 func defaultClientContext(c Client, committeeAcc *wallet.Account) (*clientContext, error) {
@@ -103,7 +149,18 @@ func defaultClientContext(c Client, committeeAcc *wallet.Account) (*clientContex
 	}, nil
 }
 
+// sendTx sends the transaction and optionally waits for it to persist.
+// In dry-run mode (see commonflags.DryRun) the transaction is only printed.
 func (c *clientContext) sendTx(tx *transaction.Transaction, cmd *cobra.Command, await bool) error {
+	if viper.GetBool(commonflags.DryRun) {
+		cmd.Printf("Transaction %s is not sent (%s):\n", tx.Hash().StringLE(), common.Colorize(cmd, common.ColorYellow, "dry run"))
+		cmd.Printf("\tscript: %s\n", base64.StdEncoding.EncodeToString(tx.Script))
+		cmd.Printf("\tsystem fee: %s GAS\n", fixedn.Fixed8(tx.SystemFee))
+		cmd.Printf("\tnetwork fee: %s GAS\n", fixedn.Fixed8(tx.NetworkFee))
+		cmd.Printf("\tvalid until block: %d\n", tx.ValidUntilBlock)
+		return nil
+	}
+
 	h, err := c.Client.SendRawTransaction(tx)
 	if err != nil {
 		return err
@@ -0,0 +1,21 @@
+package morph
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunClient(t *testing.T) {
+	c := dryRunClient{}
+
+	_, err := c.SendRawTransaction(new(transaction.Transaction))
+	require.ErrorIs(t, err, errDryRun)
+
+	_, err = c.SignAndPushInvocationTx(nil, nil, 0, 0, nil)
+	require.ErrorIs(t, err, errDryRun)
+
+	_, err = c.SignAndPushP2PNotaryRequest(nil, nil, 0, 0, 0, nil)
+	require.ErrorIs(t, err, errDryRun)
+}
@@ -0,0 +1,174 @@
+package morph
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/network/payload"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+)
+
+// tracingClient is a Client wrapper recording the duration of each call
+// (see common.TraceSpan).
+type tracingClient struct {
+	Client
+}
+
+func (c tracingClient) TerminateSession(sessionID uuid.UUID) (bool, error) {
+	start := time.Now()
+	res, err := c.Client.TerminateSession(sessionID)
+	common.TraceSpan("TerminateSession", start, err)
+	return res, err
+}
+
+func (c tracingClient) TraverseIterator(sessionID, iteratorID uuid.UUID, maxItemsCount int) ([]stackitem.Item, error) {
+	start := time.Now()
+	res, err := c.Client.TraverseIterator(sessionID, iteratorID, maxItemsCount)
+	common.TraceSpan("TraverseIterator", start, err)
+	return res, err
+}
+
+func (c tracingClient) InvokeContractVerify(contract util.Uint160, params []smartcontract.Parameter, signers []transaction.Signer, witnesses ...transaction.Witness) (*result.Invoke, error) {
+	start := time.Now()
+	res, err := c.Client.InvokeContractVerify(contract, params, signers, witnesses...)
+	common.TraceSpan("InvokeContractVerify", start, err)
+	return res, err
+}
+
+func (c tracingClient) InvokeFunction(contract util.Uint160, operation string, params []smartcontract.Parameter, signers []transaction.Signer) (*result.Invoke, error) {
+	start := time.Now()
+	res, err := c.Client.InvokeFunction(contract, operation, params, signers)
+	common.TraceSpan("InvokeFunction "+operation, start, err)
+	return res, err
+}
+
+func (c tracingClient) InvokeScript(script []byte, signers []transaction.Signer) (*result.Invoke, error) {
+	start := time.Now()
+	res, err := c.Client.InvokeScript(script, signers)
+	common.TraceSpan("InvokeScript", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetBlockCount() (uint32, error) {
+	start := time.Now()
+	res, err := c.Client.GetBlockCount()
+	common.TraceSpan("GetBlockCount", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetContractStateByID(id int32) (*state.Contract, error) {
+	start := time.Now()
+	res, err := c.Client.GetContractStateByID(id)
+	common.TraceSpan("GetContractStateByID", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetContractStateByHash(h util.Uint160) (*state.Contract, error) {
+	start := time.Now()
+	res, err := c.Client.GetContractStateByHash(h)
+	common.TraceSpan("GetContractStateByHash", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetNativeContracts() ([]state.NativeContract, error) {
+	start := time.Now()
+	res, err := c.Client.GetNativeContracts()
+	common.TraceSpan("GetNativeContracts", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetNetwork() (netmode.Magic, error) {
+	start := time.Now()
+	res, err := c.Client.GetNetwork()
+	common.TraceSpan("GetNetwork", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetApplicationLog(h util.Uint256, t *trigger.Type) (*result.ApplicationLog, error) {
+	start := time.Now()
+	res, err := c.Client.GetApplicationLog(h, t)
+	common.TraceSpan("GetApplicationLog", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetVersion() (*result.Version, error) {
+	start := time.Now()
+	res, err := c.Client.GetVersion()
+	common.TraceSpan("GetVersion", start, err)
+	return res, err
+}
+
+func (c tracingClient) CreateTxFromScript(script []byte, acc *wallet.Account, sysFee, netFee int64, cosigners []rpcclient.SignerAccount) (*transaction.Transaction, error) {
+	start := time.Now()
+	res, err := c.Client.CreateTxFromScript(script, acc, sysFee, netFee, cosigners)
+	common.TraceSpan("CreateTxFromScript", start, err)
+	return res, err
+}
+
+func (c tracingClient) NEP17BalanceOf(tokenHash, acc util.Uint160) (int64, error) {
+	start := time.Now()
+	res, err := c.Client.NEP17BalanceOf(tokenHash, acc)
+	common.TraceSpan("NEP17BalanceOf", start, err)
+	return res, err
+}
+
+func (c tracingClient) SendRawTransaction(tx *transaction.Transaction) (util.Uint256, error) {
+	start := time.Now()
+	res, err := c.Client.SendRawTransaction(tx)
+	common.TraceSpan("SendRawTransaction", start, err)
+	return res, err
+}
+
+func (c tracingClient) GetCommittee() (keys.PublicKeys, error) {
+	start := time.Now()
+	res, err := c.Client.GetCommittee()
+	common.TraceSpan("GetCommittee", start, err)
+	return res, err
+}
+
+func (c tracingClient) CalculateNotaryFee(nKeys uint8) (int64, error) {
+	start := time.Now()
+	res, err := c.Client.CalculateNotaryFee(nKeys)
+	common.TraceSpan("CalculateNotaryFee", start, err)
+	return res, err
+}
+
+func (c tracingClient) CalculateNetworkFee(tx *transaction.Transaction) (int64, error) {
+	start := time.Now()
+	res, err := c.Client.CalculateNetworkFee(tx)
+	common.TraceSpan("CalculateNetworkFee", start, err)
+	return res, err
+}
+
+func (c tracingClient) AddNetworkFee(tx *transaction.Transaction, extraFee int64, accs ...*wallet.Account) error {
+	start := time.Now()
+	err := c.Client.AddNetworkFee(tx, extraFee, accs...)
+	common.TraceSpan("AddNetworkFee", start, err)
+	return err
+}
+
+func (c tracingClient) SignAndPushInvocationTx(script []byte, acc *wallet.Account, sysFee int64, netFee fixedn.Fixed8, cosigners []rpcclient.SignerAccount) (util.Uint256, error) {
+	start := time.Now()
+	res, err := c.Client.SignAndPushInvocationTx(script, acc, sysFee, netFee, cosigners)
+	common.TraceSpan("SignAndPushInvocationTx", start, err)
+	return res, err
+}
+
+func (c tracingClient) SignAndPushP2PNotaryRequest(mainTx *transaction.Transaction, fallbackScript []byte, fallbackSysFee, fallbackNetFee int64, fallbackValidFor uint32, acc *wallet.Account) (*payload.P2PNotaryRequest, error) {
+	start := time.Now()
+	res, err := c.Client.SignAndPushP2PNotaryRequest(mainTx, fallbackScript, fallbackSysFee, fallbackNetFee, fallbackValidFor, acc)
+	common.TraceSpan("SignAndPushP2PNotaryRequest", start, err)
+	return res, err
+}
@@ -5,7 +5,6 @@ import (
 	"math/big"
 	"strconv"
 
-	"github.com/nspcc-dev/neo-go/cli/input"
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
@@ -14,6 +13,8 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/nep17"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/notary"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -23,11 +24,13 @@ import (
 const defaultNotaryDepositLifetime = 5760
 
 func depositNotary(cmd *cobra.Command, _ []string) error {
-	p, err := cmd.Flags().GetString(storageWalletFlag)
-	if err != nil {
-		return err
-	} else if p == "" {
-		return fmt.Errorf("missing wallet path (use '--%s <out.json>')", storageWalletFlag)
+	p := viper.GetString(commonflags.WalletPath)
+	if p == "" {
+		// storageWalletFlag is deprecated, but still supported
+		p, _ = cmd.Flags().GetString(storageWalletFlag)
+	}
+	if p == "" {
+		return fmt.Errorf("missing wallet path (use '--%s <out.json>')", commonflags.WalletPath)
 	}
 
 	w, err := wallet.NewWalletFromFile(p)
@@ -49,7 +52,7 @@ func depositNotary(cmd *cobra.Command, _ []string) error {
 	}
 
 	prompt := fmt.Sprintf("Enter password for %s >", address.Uint160ToString(accHash))
-	pass, err := input.ReadPassword(prompt)
+	pass, err := common.ReadWalletPassword(prompt)
 	if err != nil {
 		return fmt.Errorf("can't get password: %v", err)
 	}
@@ -1,14 +1,23 @@
 package morph
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
 	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/policy"
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -19,31 +28,93 @@ const (
 	setFeeParam       = "FeePerByte"
 )
 
-func setPolicyCmd(cmd *cobra.Command, args []string) error {
-	wCtx, err := newInitializeContext(cmd, viper.GetViper())
+// policyParamRange is an allowed range of the policy parameter value as
+// checked by the native Policy contract.
+type policyParamRange struct {
+	min, max int64
+}
+
+var policyParamRanges = map[string]policyParamRange{
+	execFeeParam:      {min: 1, max: 100},
+	storagePriceParam: {min: 1, max: 10_000_000},
+	setFeeParam:       {min: 0, max: 100_000_000},
+}
+
+// gasSuffix marks policy parameter value specified in GAS rather than in
+// raw contract units (1e-8 GAS).
+const gasSuffix = "gas"
+
+// parsePolicyParam parses "Parameter=Value" pair and checks that the value
+// fits the range allowed by the Policy contract. GAS-denominated parameters
+// (StoragePrice and FeePerByte) can also be specified in GAS with "gas"
+// suffix, e.g. "FeePerByte=0.00001gas".
+func parsePolicyParam(arg string) (string, int64, error) {
+	kv := strings.SplitN(arg, "=", 2)
+	if len(kv) != 2 {
+		return "", 0, fmt.Errorf("invalid parameter format, must be Parameter=Value")
+	}
+
+	r, ok := policyParamRanges[kv[0]]
+	if !ok {
+		return "", 0, fmt.Errorf("parameter must be one of %s, %s and %s", execFeeParam, storagePriceParam, setFeeParam)
+	}
+
+	value, err := parsePolicyValue(kv[0], kv[1])
 	if err != nil {
-		return fmt.Errorf("can't to initialize context: %w", err)
+		return "", 0, fmt.Errorf("can't parse parameter value '%s': %w", arg, err)
 	}
 
-	bw := io.NewBufBinWriter()
-	for i := range args {
-		kv := strings.SplitN(args[i], "=", 2)
-		if len(kv) != 2 {
-			return fmt.Errorf("invalid parameter format, must be Parameter=Value")
+	if value < r.min || r.max < value {
+		return "", 0, fmt.Errorf("%s must be between %d and %d, got %d", kv[0], r.min, r.max, value)
+	}
+
+	return kv[0], value, nil
+}
+
+func parsePolicyValue(param, s string) (int64, error) {
+	if v := strings.TrimSuffix(strings.ToLower(s), gasSuffix); len(v) != len(s) {
+		if param == execFeeParam {
+			return 0, fmt.Errorf("%s is not GAS-denominated", execFeeParam)
 		}
 
-		switch kv[0] {
-		case execFeeParam, storagePriceParam, setFeeParam:
-		default:
-			return fmt.Errorf("parameter must be one of %s, %s and %s", execFeeParam, storagePriceParam, setFeeParam)
+		gasValue, err := fixedn.Fixed8FromString(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid GAS amount: %w", err)
 		}
 
-		value, err := strconv.ParseUint(kv[1], 10, 32)
+		return int64(gasValue), nil
+	}
+
+	if strings.Contains(s, ".") {
+		return 0, fmt.Errorf("fractional value must have '%s' suffix", gasSuffix)
+	}
+
+	value, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(value), nil
+}
+
+func setPolicyCmd(cmd *cobra.Command, args []string) error {
+	bw := io.NewBufBinWriter()
+	for i := range args {
+		k, v, err := parsePolicyParam(args[i])
 		if err != nil {
-			return fmt.Errorf("can't parse parameter value '%s': %w", args[1], err)
+			return err
 		}
 
-		emit.AppCall(bw.BinWriter, policy.Hash, "set"+kv[0], callflag.All, int64(value))
+		emit.AppCall(bw.BinWriter, policy.Hash, "set"+k, callflag.All, v)
+	}
+
+	wCtx, err := newInitializeContext(cmd, viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't to initialize context: %w", err)
+	}
+
+	if err := common.Confirm(cmd, "Set policy values "+strings.Join(args, ", ")+"?"); err != nil {
+		return err
 	}
 
 	if err := wCtx.sendCommitteeTx(bw.Bytes(), false); err != nil {
@@ -52,3 +123,96 @@ func setPolicyCmd(cmd *cobra.Command, args []string) error {
 
 	return wCtx.awaitTx()
 }
+
+// blockAccountCmd returns RunE handler that calls policy contract method
+// (either "blockAccount" or "unblockAccount") for the account from the
+// first argument.
+func blockAccountCmd(method string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		acc, err := parseAccount(args[0])
+		if err != nil {
+			return err
+		}
+
+		wCtx, err := newInitializeContext(cmd, viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("can't to initialize context: %w", err)
+		}
+
+		bw := io.NewBufBinWriter()
+		emit.AppCall(bw.BinWriter, policy.Hash, method, callflag.All, acc)
+		emit.Opcodes(bw.BinWriter, opcode.ASSERT)
+		if bw.Err != nil {
+			return fmt.Errorf("can't form raw transaction: %w", bw.Err)
+		}
+
+		if err := common.Confirm(cmd, fmt.Sprintf("Call %s for %s?", method, address.Uint160ToString(acc))); err != nil {
+			return err
+		}
+
+		if err := wCtx.sendCommitteeTx(bw.Bytes(), false); err != nil {
+			return err
+		}
+
+		return wCtx.awaitTx()
+	}
+}
+
+// parseAccount decodes account from either N3 address or
+// little-endian hex-encoded script hash.
+func parseAccount(s string) (util.Uint160, error) {
+	acc, err := address.StringToUint160(s)
+	if err != nil {
+		acc, err = util.Uint160DecodeStringLE(strings.TrimPrefix(s, "0x"))
+		if err != nil {
+			return util.Uint160{}, fmt.Errorf("invalid account '%s': must be N3 address or script hash", s)
+		}
+	}
+
+	if acc.Equals(util.Uint160{}) {
+		return util.Uint160{}, errors.New("zero account can't be used")
+	}
+
+	return acc, nil
+}
+
+func getPolicyCmd(cmd *cobra.Command, _ []string) error {
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	pr := policy.NewReader(invoker.New(c, nil))
+
+	execFee, err := pr.GetExecFeeFactor()
+	if err != nil {
+		return fmt.Errorf("can't get %s: %w", execFeeParam, err)
+	}
+
+	storagePrice, err := pr.GetStoragePrice()
+	if err != nil {
+		return fmt.Errorf("can't get %s: %w", storagePriceParam, err)
+	}
+
+	feePerByte, err := pr.GetFeePerByte()
+	if err != nil {
+		return fmt.Errorf("can't get %s: %w", setFeeParam, err)
+	}
+
+	res := map[string]int64{
+		execFeeParam:      execFee,
+		storagePriceParam: storagePrice,
+		setFeeParam:       feePerByte,
+	}
+
+	return common.PrintResult(cmd, res, func() {
+		buf := bytes.NewBuffer(nil)
+		tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+		for _, k := range []string{execFeeParam, storagePriceParam, setFeeParam} {
+			_, _ = fmt.Fprintf(tw, "%s:\t%d\n", k, res[k])
+		}
+		_ = tw.Flush()
+
+		cmd.Print(buf.String())
+	})
+}
@@ -0,0 +1,53 @@
+package morph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicyParam(t *testing.T) {
+	testCases := []struct {
+		arg   string
+		value int64
+		valid bool
+	}{
+		{arg: "ExecFeeFactor=0"},
+		{arg: "ExecFeeFactor=1", value: 1, valid: true},
+		{arg: "ExecFeeFactor=100", value: 100, valid: true},
+		{arg: "ExecFeeFactor=101"},
+		{arg: "StoragePrice=0"},
+		{arg: "StoragePrice=1", value: 1, valid: true},
+		{arg: "StoragePrice=10000000", value: 10000000, valid: true},
+		{arg: "StoragePrice=10000001"},
+		{arg: "StoragePrice=4000000000"},
+		{arg: "FeePerByte=0", value: 0, valid: true},
+		{arg: "FeePerByte=100000000", value: 100000000, valid: true},
+		{arg: "FeePerByte=100000001"},
+		{arg: "FeePerByte=-1"},
+		{arg: "FeePerByte=0.00001gas", value: 1000, valid: true},
+		{arg: "FeePerByte=1GAS", value: 100000000, valid: true},
+		{arg: "FeePerByte=1.00000001gas"},
+		{arg: "FeePerByte=0.5"},
+		{arg: "FeePerByte=gas"},
+		{arg: "StoragePrice=0.1gas", value: 10000000, valid: true},
+		{arg: "StoragePrice=0.00000001gas", value: 1, valid: true},
+		{arg: "StoragePrice=0gas"},
+		{arg: "ExecFeeFactor=1gas"},
+		{arg: "FeePerByte"},
+		{arg: "Unknown=1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.arg, func(t *testing.T) {
+			_, v, err := parsePolicyParam(tc.arg)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.value, v)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
 	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
 	netmapcontract "github.com/nspcc-dev/neofs-contract/netmap"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -27,6 +28,10 @@ func removeNodesCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if err := common.Confirm(cmd, fmt.Sprintf("Remove %d node(s) from the netmap?", len(nodeKeys))); err != nil {
+		return err
+	}
+
 	wCtx, err := newInitializeContext(cmd, viper.GetViper())
 	if err != nil {
 		return fmt.Errorf("can't initialize context: %w", err)
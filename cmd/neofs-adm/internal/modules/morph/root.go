@@ -1,6 +1,7 @@
 package morph
 
 import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -8,7 +9,6 @@ import (
 const (
 	alphabetWalletsFlag             = "alphabet-wallets"
 	alphabetSizeFlag                = "size"
-	endpointFlag                    = "rpc-endpoint"
 	storageWalletFlag               = "storage-wallet"
 	storageWalletLabelFlag          = "label"
 	storageGasCLIFlag               = "initial-gas"
@@ -66,7 +66,7 @@ var (
 		Short: "Initialize side chain network with smart-contracts and network settings",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 			_ = viper.BindPFlag(epochDurationInitFlag, cmd.Flags().Lookup(epochDurationCLIFlag))
 			_ = viper.BindPFlag(maxObjectSizeInitFlag, cmd.Flags().Lookup(maxObjectSizeCLIFlag))
 			_ = viper.BindPFlag(incomeRateInitFlag, cmd.Flags().Lookup(incomeRateCLIFlag))
@@ -87,7 +87,7 @@ var (
 		Short: "Generate storage node wallet for the morph network",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 			_ = viper.BindPFlag(storageGasConfigFlag, cmd.Flags().Lookup(storageGasCLIFlag))
 		},
 		RunE: generateStorageCreds,
@@ -98,7 +98,7 @@ var (
 		Short: "Refill GAS of storage node's wallet in the morph network",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 			_ = viper.BindPFlag(refillGasAmountFlag, cmd.Flags().Lookup(refillGasAmountFlag))
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -111,7 +111,7 @@ var (
 		Short: "Create new NeoFS epoch event in the side chain",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: forceNewEpochCmd,
 	}
@@ -122,7 +122,7 @@ var (
 		Long:  `Move nodes to the Offline state in the candidates list and tick an epoch to update the netmap`,
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: removeNodesCmd,
 	}
@@ -133,7 +133,7 @@ var (
 		Short:                 "Add/update global config value in the NeoFS network",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		Args: cobra.MinimumNArgs(1),
 		RunE: setConfigCmd,
@@ -143,9 +143,11 @@ var (
 		Use:                   "set-policy [ExecFeeFactor=<n1>] [StoragePrice=<n2>] [FeePerByte=<n3>]",
 		DisableFlagsInUseLine: true,
 		Short:                 "Set global policy values",
+		Long: "Set global policy values. StoragePrice and FeePerByte can be specified either in " +
+			"raw contract units or in GAS with 'gas' suffix (e.g. FeePerByte=0.00001gas).",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: setPolicyCmd,
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -153,11 +155,42 @@ var (
 		},
 	}
 
+	blockAccount = &cobra.Command{
+		Use:   "block-account <address>",
+		Short: "Block account in the policy contract",
+		Args:  cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
+		},
+		RunE: blockAccountCmd("blockAccount"),
+	}
+
+	unblockAccount = &cobra.Command{
+		Use:   "unblock-account <address>",
+		Short: "Unblock account in the policy contract",
+		Args:  cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
+		},
+		RunE: blockAccountCmd("unblockAccount"),
+	}
+
+	getPolicy = &cobra.Command{
+		Use:   "get-policy",
+		Short: "Get global policy values",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
+		},
+		RunE: getPolicyCmd,
+	}
+
 	dumpContractHashesCmd = &cobra.Command{
 		Use:   "dump-hashes",
 		Short: "Dump deployed contract hashes",
 		PreRun: func(cmd *cobra.Command, _ []string) {
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: dumpContractHashes,
 	}
@@ -166,7 +199,7 @@ var (
 		Use:   "dump-config",
 		Short: "Dump NeoFS network config",
 		PreRun: func(cmd *cobra.Command, _ []string) {
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: dumpNetworkConfig,
 	}
@@ -175,7 +208,7 @@ var (
 		Use:   "dump-balances",
 		Short: "Dump GAS balances",
 		PreRun: func(cmd *cobra.Command, _ []string) {
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: dumpBalances,
 	}
@@ -185,7 +218,7 @@ var (
 		Short: "Update NeoFS contracts",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: updateContracts,
 	}
@@ -194,7 +227,7 @@ var (
 		Use:   "dump-containers",
 		Short: "Dump NeoFS containers to file",
 		PreRun: func(cmd *cobra.Command, _ []string) {
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: dumpContainers,
 	}
@@ -204,7 +237,7 @@ var (
 		Short: "Restore NeoFS containers from file",
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: restoreContainers,
 	}
@@ -213,7 +246,7 @@ var (
 		Use:   "list-containers",
 		Short: "List NeoFS containers",
 		PreRun: func(cmd *cobra.Command, _ []string) {
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
 		},
 		RunE: listContainers,
 	}
@@ -222,7 +255,8 @@ var (
 		Use:   "deposit-notary",
 		Short: "Deposit GAS for notary service",
 		PreRun: func(cmd *cobra.Command, _ []string) {
-			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.EndpointFlag))
+			_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.WalletPath))
 		},
 		RunE: depositNotary,
 	}
@@ -235,7 +269,7 @@ func init() {
 
 	RootCmd.AddCommand(initCmd)
 	initCmd.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	initCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	initCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	initCmd.Flags().String(contractsInitFlag, "", "Path to archive with compiled NeoFS contracts (default fetched from latest github release)")
 	initCmd.Flags().Uint(epochDurationCLIFlag, 240, "Amount of side chain blocks in one NeoFS epoch")
 	initCmd.Flags().Uint(maxObjectSizeCLIFlag, 67108864, "Max single object size in bytes")
@@ -250,37 +284,48 @@ func init() {
 
 	RootCmd.AddCommand(generateStorageCmd)
 	generateStorageCmd.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	generateStorageCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	generateStorageCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	generateStorageCmd.Flags().String(storageWalletFlag, "", "Path to new storage node wallet")
 	generateStorageCmd.Flags().String(storageGasCLIFlag, "", "Initial amount of GAS to transfer")
 	generateStorageCmd.Flags().StringP(storageWalletLabelFlag, "l", "", "Wallet label")
 
 	RootCmd.AddCommand(forceNewEpoch)
 	forceNewEpoch.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	forceNewEpoch.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	forceNewEpoch.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 
 	RootCmd.AddCommand(removeNodes)
 	removeNodes.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	removeNodes.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	removeNodes.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 
 	RootCmd.AddCommand(setPolicy)
 	setPolicy.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	setPolicy.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	setPolicy.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
+
+	RootCmd.AddCommand(blockAccount)
+	blockAccount.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
+	blockAccount.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
+
+	RootCmd.AddCommand(unblockAccount)
+	unblockAccount.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
+	unblockAccount.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
+
+	RootCmd.AddCommand(getPolicy)
+	getPolicy.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 
 	RootCmd.AddCommand(dumpContractHashesCmd)
-	dumpContractHashesCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	dumpContractHashesCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	dumpContractHashesCmd.Flags().String(customZoneFlag, "", "Custom zone to search.")
 
 	RootCmd.AddCommand(dumpNetworkConfigCmd)
-	dumpNetworkConfigCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	dumpNetworkConfigCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 
 	RootCmd.AddCommand(setConfig)
 	setConfig.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	setConfig.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	setConfig.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	setConfig.Flags().Bool(forceConfigSet, false, "Force setting not well-known configuration key")
 
 	RootCmd.AddCommand(dumpBalancesCmd)
-	dumpBalancesCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	dumpBalancesCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	dumpBalancesCmd.Flags().BoolP(dumpBalancesStorageFlag, "s", false, "Dump balances of storage nodes from the current netmap")
 	dumpBalancesCmd.Flags().BoolP(dumpBalancesAlphabetFlag, "a", false, "Dump balances of alphabet contracts")
 	dumpBalancesCmd.Flags().BoolP(dumpBalancesProxyFlag, "p", false, "Dump balances of the proxy contract")
@@ -288,28 +333,28 @@ func init() {
 
 	RootCmd.AddCommand(updateContractsCmd)
 	updateContractsCmd.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	updateContractsCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	updateContractsCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	updateContractsCmd.Flags().String(contractsInitFlag, "", "Path to archive with compiled NeoFS contracts (default fetched from latest github release)")
 
 	RootCmd.AddCommand(dumpContainersCmd)
-	dumpContainersCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	dumpContainersCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	dumpContainersCmd.Flags().String(containerDumpFlag, "", "File where to save dumped containers")
 	dumpContainersCmd.Flags().String(containerContractFlag, "", "Container contract hash (for networks without NNS)")
 	dumpContainersCmd.Flags().StringSlice(containerIDsFlag, nil, "Containers to dump")
 
 	RootCmd.AddCommand(restoreContainersCmd)
 	restoreContainersCmd.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	restoreContainersCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	restoreContainersCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	restoreContainersCmd.Flags().String(containerDumpFlag, "", "File to restore containers from")
 	restoreContainersCmd.Flags().StringSlice(containerIDsFlag, nil, "Containers to restore")
 
 	RootCmd.AddCommand(listContainersCmd)
-	listContainersCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	listContainersCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	listContainersCmd.Flags().String(containerContractFlag, "", "Container contract hash (for networks without NNS)")
 
 	RootCmd.AddCommand(refillGasCmd)
 	refillGasCmd.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
-	refillGasCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	refillGasCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 	refillGasCmd.Flags().String(storageWalletFlag, "", "Path to storage node wallet")
 	refillGasCmd.Flags().String(walletAddressFlag, "", "Address of wallet")
 	refillGasCmd.Flags().String(refillGasAmountFlag, "", "Additional amount of GAS to transfer")
@@ -318,8 +363,10 @@ func init() {
 	RootCmd.AddCommand(cmdSubnet)
 
 	RootCmd.AddCommand(depositNotaryCmd)
-	depositNotaryCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	depositNotaryCmd.Flags().StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
+	depositNotaryCmd.Flags().StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, "", commonflags.WalletPathUsage)
 	depositNotaryCmd.Flags().String(storageWalletFlag, "", "Path to storage node wallet")
+	_ = depositNotaryCmd.Flags().MarkDeprecated(storageWalletFlag, "use --"+commonflags.WalletPath+" instead")
 	depositNotaryCmd.Flags().String(walletAccountFlag, "", "Wallet account address")
 	depositNotaryCmd.Flags().String(refillGasAmountFlag, "", "Amount of GAS to deposit")
 	depositNotaryCmd.Flags().String(notaryDepositTillFlag, "", "Notary deposit duration in blocks")
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/nspcc-dev/neo-go/cli/flags"
-	"github.com/nspcc-dev/neo-go/cli/input"
 	"github.com/nspcc-dev/neo-go/pkg/core/native/nativenames"
 	"github.com/nspcc-dev/neo-go/pkg/core/state"
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
@@ -20,6 +19,8 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/morph/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	"github.com/nspcc-dev/neofs-node/pkg/util/rand"
@@ -42,7 +43,7 @@ var cmdSubnet = &cobra.Command{
 	Short: "NeoFS subnet management",
 	PreRun: func(cmd *cobra.Command, _ []string) {
 		viperBindFlags(cmd,
-			endpointFlag,
+			commonflags.EndpointFlag,
 		)
 	},
 }
@@ -51,17 +52,16 @@ var cmdSubnet = &cobra.Command{
 const (
 	flagSubnet        = "subnet"  // subnet identifier
 	flagSubnetGroup   = "group"   // subnet client group ID
-	flagSubnetWallet  = "wallet"  // filepath to wallet
 	flagSubnetAddress = "address" // address in the wallet, optional
 )
 
-// reads wallet from the filepath configured in flagSubnetWallet flag,
+// reads wallet from the filepath configured in commonflags.WalletPath flag,
 // looks for address specified in flagSubnetAddress flag (uses default
 // address if flag is empty) and decrypts private key.
 func readSubnetKey(key *keys.PrivateKey) error {
 	// read wallet from file
 
-	walletPath := viper.GetString(flagSubnetWallet)
+	walletPath := viper.GetString(commonflags.WalletPath)
 	if walletPath == "" {
 		return errors.New("missing path to wallet")
 	}
@@ -93,7 +93,7 @@ func readSubnetKey(key *keys.PrivateKey) error {
 	}
 
 	// read password
-	pass, err := input.ReadPassword("Enter password > ")
+	pass, err := common.ReadWalletPassword("Enter password > ")
 	if err != nil {
 		return fmt.Errorf("read password: %w", err)
 	}
@@ -115,7 +115,7 @@ var cmdSubnetCreate = &cobra.Command{
 	Short: "Create NeoFS subnet",
 	PreRun: func(cmd *cobra.Command, _ []string) {
 		viperBindFlags(cmd,
-			flagSubnetWallet,
+			commonflags.WalletPath,
 			flagSubnetAddress,
 		)
 	},
@@ -180,7 +180,7 @@ var cmdSubnetRemove = &cobra.Command{
 	Short: "Remove NeoFS subnet",
 	PreRun: func(cmd *cobra.Command, _ []string) {
 		viperBindFlags(cmd,
-			flagSubnetWallet,
+			commonflags.WalletPath,
 			flagSubnetAddress,
 			flagSubnetRemoveID,
 		)
@@ -293,7 +293,7 @@ var cmdSubnetAdmin = &cobra.Command{
 	Short: "Manage administrators of the NeoFS subnet",
 	PreRun: func(cmd *cobra.Command, args []string) {
 		viperBindFlags(cmd,
-			flagSubnetWallet,
+			commonflags.WalletPath,
 			flagSubnetAddress,
 			flagSubnetAdminSubnet,
 			flagSubnetAdminID,
@@ -436,7 +436,7 @@ var cmdSubnetClient = &cobra.Command{
 	Short: "Manage clients of the NeoFS subnet",
 	PreRun: func(cmd *cobra.Command, _ []string) {
 		viperBindFlags(cmd,
-			flagSubnetWallet,
+			commonflags.WalletPath,
 			flagSubnetAddress,
 			flagSubnetClientSubnet,
 			flagSubnetClientID,
@@ -601,7 +601,7 @@ var cmdSubnetNode = &cobra.Command{
 	Short: "Manage nodes of the NeoFS subnet",
 	PreRun: func(cmd *cobra.Command, _ []string) {
 		viperBindFlags(cmd,
-			flagSubnetWallet,
+			commonflags.WalletPath,
 			flagSubnetNode,
 			flagSubnetNodeSubnet,
 		)
@@ -652,8 +652,7 @@ func addCommandInheritPreRun(par *cobra.Command, subs ...*cobra.Command) {
 
 // registers flags and binds sub-commands for subnet commands.
 func init() {
-	cmdSubnetCreate.Flags().StringP(flagSubnetWallet, "w", "", "Path to file with wallet")
-	_ = cmdSubnetCreate.MarkFlagRequired(flagSubnetWallet)
+	cmdSubnetCreate.Flags().StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, "", commonflags.WalletPathUsage)
 	cmdSubnetCreate.Flags().StringP(flagSubnetAddress, "a", "", "Address in the wallet, optional")
 
 	// get subnet flags
@@ -663,8 +662,7 @@ func init() {
 	// remove subnet flags
 	cmdSubnetRemove.Flags().String(flagSubnetRemoveID, "", "ID of the subnet to remove")
 	_ = cmdSubnetRemove.MarkFlagRequired(flagSubnetRemoveID)
-	cmdSubnetRemove.Flags().StringP(flagSubnetWallet, "w", "", "Path to file with wallet")
-	_ = cmdSubnetRemove.MarkFlagRequired(flagSubnetWallet)
+	cmdSubnetRemove.Flags().StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, "", commonflags.WalletPathUsage)
 	cmdSubnetRemove.Flags().StringP(flagSubnetAddress, "a", "", "Address in the wallet, optional")
 
 	// subnet administer flags
@@ -673,8 +671,7 @@ func init() {
 	_ = cmdSubnetAdmin.MarkFlagRequired(flagSubnetAdminSubnet)
 	adminFlags.String(flagSubnetAdminID, "", "Hex-encoded public key of the admin")
 	_ = cmdSubnetAdmin.MarkFlagRequired(flagSubnetAdminID)
-	adminFlags.StringP(flagSubnetWallet, "w", "", "Path to file with wallet")
-	_ = cmdSubnetAdmin.MarkFlagRequired(flagSubnetWallet)
+	adminFlags.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, "", commonflags.WalletPathUsage)
 	adminFlags.StringP(flagSubnetAddress, "a", "", "Address in the wallet, optional")
 
 	// add admin flags
@@ -695,8 +692,7 @@ func init() {
 	_ = cmdSubnetClient.MarkFlagRequired(flagSubnetClientGroup)
 	clientFlags.String(flagSubnetClientID, "", "Client's user ID in NeoFS system in text format")
 	_ = cmdSubnetClient.MarkFlagRequired(flagSubnetClientID)
-	clientFlags.StringP(flagSubnetWallet, "w", "", "Path to file with wallet")
-	_ = cmdSubnetClient.MarkFlagRequired(flagSubnetWallet)
+	clientFlags.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, "", commonflags.WalletPathUsage)
 	clientFlags.StringP(flagSubnetAddress, "a", "", "Address in the wallet, optional")
 
 	// add all admin managing commands to corresponding command section
@@ -713,8 +709,7 @@ func init() {
 
 	// subnet node flags
 	nodeFlags := cmdSubnetNode.PersistentFlags()
-	nodeFlags.StringP(flagSubnetWallet, "w", "", "Path to file with wallet")
-	_ = cmdSubnetNode.MarkFlagRequired(flagSubnetWallet)
+	nodeFlags.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, "", commonflags.WalletPathUsage)
 	nodeFlags.String(flagSubnetNode, "", "Hex-encoded public key of the node")
 	_ = cmdSubnetNode.MarkFlagRequired(flagSubnetNode)
 	nodeFlags.String(flagSubnetNodeSubnet, "", "ID of the subnet to manage nodes")
@@ -728,8 +723,7 @@ func init() {
 
 	// subnet global flags
 	cmdSubnetFlags := cmdSubnet.PersistentFlags()
-	cmdSubnetFlags.StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
-	_ = cmdSubnet.MarkFlagRequired(endpointFlag)
+	cmdSubnetFlags.StringP(commonflags.EndpointFlag, commonflags.EndpointFlagShorthand, "", commonflags.EndpointFlagUsage)
 
 	// add all subnet commands to corresponding command section
 	addCommandInheritPreRun(cmdSubnet,
@@ -1029,7 +1023,11 @@ func notaryWitnesses(c Client, alphabet, acc *wallet.Account, tx *transaction.Tr
 		VerificationScript: alphabet.GetVerificationScript(),
 	})
 
-	magicNumber, _ := c.GetNetwork()
+	magicNumber, err := c.GetNetwork()
+	if err != nil {
+		// node is unavailable, fallback to the explicitly set magic
+		magicNumber, _ = common.NetworkMagic()
+	}
 
 	// caller's witness
 	ww = append(ww, transaction.Witness{
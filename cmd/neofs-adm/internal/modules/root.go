@@ -3,6 +3,8 @@ package modules
 import (
 	"os"
 
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/config"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/morph"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/storagecfg"
@@ -11,6 +13,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util/gendoc"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.uber.org/zap"
 )
 
 var (
@@ -19,22 +22,47 @@ var (
 		Short: "NeoFS Administrative Tool",
 		Long: `NeoFS Administrative Tool provides functions to setup and
 manage NeoFS network deployment.`,
-		RunE:         entryPoint,
-		SilenceUsage: true,
+		PersistentPreRunE: initConfig,
+		RunE:              entryPoint,
+		SilenceUsage:      true,
 	}
-
-	configFlag = "config"
 )
 
 func init() {
-	cobra.OnInitialize(func() { initConfig(rootCmd) })
 	// we need to init viper config to bind viper and cobra configurations for
 	// rpc endpoint, alphabet wallet dir, key credentials, etc.
 
 	// use stdout as default output for cmd.Print()
 	rootCmd.SetOut(os.Stdout)
 
-	rootCmd.PersistentFlags().StringP(configFlag, "c", "", "Config file")
+	commonflags.InitConfigFlags(viper.GetViper(), rootCmd.PersistentFlags())
+	rootCmd.PersistentFlags().Bool(commonflags.StrictConfig, false, commonflags.StrictConfigUsage)
+	rootCmd.PersistentFlags().String(commonflags.Profile, "", commonflags.ProfileUsage)
+	rootCmd.PersistentFlags().CountP(commonflags.Verbose, commonflags.VerboseShorthand, commonflags.VerboseUsage)
+	rootCmd.PersistentFlags().BoolP(commonflags.Quiet, commonflags.QuietShorthand, false, commonflags.QuietUsage)
+	rootCmd.MarkFlagsMutuallyExclusive(commonflags.Verbose, commonflags.Quiet)
+	rootCmd.PersistentFlags().String(commonflags.LogLevel, commonflags.LogLevelDefault, commonflags.LogLevelUsage)
+	rootCmd.PersistentFlags().DurationP(commonflags.Timeout, commonflags.TimeoutShorthand, commonflags.TimeoutDefault, commonflags.TimeoutUsage)
+	rootCmd.PersistentFlags().String(commonflags.OutputFormat, commonflags.OutputFormatDefault, commonflags.OutputFormatUsage)
+	rootCmd.PersistentFlags().String(commonflags.Color, commonflags.ColorDefault, commonflags.ColorUsage)
+	rootCmd.PersistentFlags().Bool(commonflags.NoColor, false, commonflags.NoColorUsage)
+	rootCmd.MarkFlagsMutuallyExclusive(commonflags.Color, commonflags.NoColor)
+	rootCmd.PersistentFlags().String(commonflags.WalletPassword, "", commonflags.WalletPasswordUsage)
+	rootCmd.PersistentFlags().String(commonflags.WalletPasswordFile, "", commonflags.WalletPasswordFileUsage)
+	rootCmd.MarkFlagsMutuallyExclusive(commonflags.WalletPassword, commonflags.WalletPasswordFile)
+	rootCmd.PersistentFlags().BoolP(commonflags.AssumeYes, commonflags.AssumeYesShorthand, false, commonflags.AssumeYesUsage)
+	rootCmd.PersistentFlags().Bool(commonflags.DryRun, false, commonflags.DryRunUsage)
+	rootCmd.PersistentFlags().Uint32(commonflags.NetworkMagic, 0, commonflags.NetworkMagicUsage)
+	rootCmd.PersistentFlags().Bool(commonflags.Trace, false, commonflags.TraceUsage)
+	commonflags.BindAll(viper.GetViper(), rootCmd.PersistentFlags())
+
+	_ = rootCmd.MarkPersistentFlagFilename(commonflags.ConfigFlag, "yml", "yaml")
+	_ = rootCmd.MarkPersistentFlagDirname(commonflags.ConfigDirFlag)
+	_ = rootCmd.MarkPersistentFlagFilename(commonflags.WalletPasswordFile)
+	_ = rootCmd.RegisterFlagCompletionFunc(commonflags.LogLevel, common.CompleteValues("debug", "info", "warn", "error"))
+	_ = rootCmd.RegisterFlagCompletionFunc(commonflags.OutputFormat, common.CompleteValues(common.OutputText, common.OutputJSON))
+	_ = rootCmd.RegisterFlagCompletionFunc(commonflags.Color, common.CompleteValues(common.ColorAuto, common.ColorAlways, common.ColorNever))
+
 	rootCmd.Flags().Bool("version", false, "Application version")
 
 	rootCmd.AddCommand(config.RootCmd)
@@ -46,26 +74,45 @@ func init() {
 }
 
 func Execute() error {
-	return rootCmd.Execute()
+	err := rootCmd.Execute()
+	common.PrintTrace(rootCmd.ErrOrStderr())
+	return err
 }
 
 func entryPoint(cmd *cobra.Command, args []string) error {
 	printVersion, _ := cmd.Flags().GetBool("version")
 	if printVersion {
-		cmd.Print(misc.BuildInfo("NeoFS Adm"))
-		return nil
+		info := misc.NewInfo("NeoFS Adm")
+		return common.PrintResult(cmd, info, func() {
+			cmd.Print(info.String())
+		})
 	}
 
 	return cmd.Usage()
 }
 
-func initConfig(cmd *cobra.Command) {
-	configFile, err := cmd.Flags().GetString(configFlag)
-	if err != nil || configFile == "" {
-		return
+func initConfig(*cobra.Command, []string) error {
+	conflicts := common.ReadConfig(viper.GetViper())
+
+	if err := common.ApplyProfile(viper.GetViper()); err != nil {
+		return err
+	}
+	if err := common.CheckOutputFormat(); err != nil {
+		return err
+	}
+	if err := common.CheckColorMode(); err != nil {
+		return err
+	}
+	if err := common.InitLogger(); err != nil {
+		return err
+	}
+
+	if p := common.DefaultConfigFile(); p != "" {
+		common.Logger().Debug("using default config file", zap.String("path", p))
+	}
+	if p := common.DefaultConfigDir(); p != "" {
+		common.Logger().Debug("using default config directory", zap.String("path", p))
 	}
 
-	viper.SetConfigType("yml")
-	viper.SetConfigFile(configFile)
-	_ = viper.ReadInConfig() // if config file is set but unavailable, ignore it
+	return common.CheckConfigConflicts(conflicts)
 }
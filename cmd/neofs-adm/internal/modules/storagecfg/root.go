@@ -18,7 +18,6 @@ import (
 
 	"github.com/chzyer/readline"
 	"github.com/nspcc-dev/neo-go/cli/flags"
-	"github.com/nspcc-dev/neo-go/cli/input"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
@@ -29,15 +28,15 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/commonflags"
 	netutil "github.com/nspcc-dev/neofs-node/pkg/network"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
-const (
-	walletFlag  = "wallet"
-	accountFlag = "account"
-)
+const accountFlag = "account"
 
 const (
 	defaultControlEndpoint = "localhost:8090"
@@ -48,13 +47,16 @@ const (
 var RootCmd = &cobra.Command{
 	Use:   "storage-config [-w wallet] [-a acccount] [<path-to-config>]",
 	Short: "Section for storage node configuration commands",
-	Run:   storageConfig,
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		_ = commonflags.Bind(viper.GetViper(), cmd.Flags().Lookup(commonflags.WalletPath))
+	},
+	Run: storageConfig,
 }
 
 func init() {
 	fs := RootCmd.Flags()
 
-	fs.StringP(walletFlag, "w", "", "Path to wallet")
+	fs.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, "", commonflags.WalletPathUsage)
 	fs.StringP(accountFlag, "a", "", "Wallet account")
 }
 
@@ -95,7 +97,7 @@ func storageConfig(cmd *cobra.Command, args []string) {
 
 	var c config
 
-	c.Wallet.Path, _ = cmd.Flags().GetString(walletFlag)
+	c.Wallet.Path = viper.GetString(commonflags.WalletPath)
 	if c.Wallet.Path == "" {
 		c.Wallet.Path = getPath("Path to the storage node wallet: ")
 	}
@@ -120,7 +122,7 @@ func storageConfig(cmd *cobra.Command, args []string) {
 		fatalOnErr(errors.New("can't find account in wallet"))
 	}
 
-	c.Wallet.Password, err = input.ReadPassword(fmt.Sprintf("Account password for %s: ", c.Wallet.Account))
+	c.Wallet.Password, err = common.ReadWalletPassword(fmt.Sprintf("Account password for %s: ", c.Wallet.Account))
 	fatalOnErr(err)
 
 	err = acc.Decrypt(c.Wallet.Password, keys.NEP2ScryptParams())
@@ -487,6 +487,8 @@ type cfgLocalStorage struct {
 type cfgObjectRoutines struct {
 	putRemote *ants.Pool
 
+	putLocal util.WorkerPool
+
 	putRemoteCapacity int
 
 	replicatorPoolSize int
@@ -825,6 +827,14 @@ func initObjectPool(cfg *config.Config) (pool cfgObjectRoutines) {
 	pool.putRemote, err = ants.NewPool(pool.putRemoteCapacity, optNonBlocking)
 	fatalOnErr(err)
 
+	if size := objectconfig.Put(cfg).PoolSizeLocal(); size > 0 {
+		// local saves wait for the free worker instead of failing
+		pool.putLocal, err = ants.NewPool(size)
+		fatalOnErr(err)
+	} else {
+		pool.putLocal = util.NewPseudoWorkerPool()
+	}
+
 	pool.replicatorPoolSize = replicatorconfig.PoolSize(cfg)
 	if pool.replicatorPoolSize <= 0 {
 		pool.replicatorPoolSize = pool.putRemoteCapacity
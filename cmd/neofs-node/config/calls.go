@@ -24,6 +24,8 @@ func (x *Config) Sub(name string) *Config {
 
 	return &Config{
 		v:           x.v,
+		opts:        x.opts,
+		handlers:    x.handlers,
 		path:        append(path, name),
 		defaultPath: append(defaultPath, name),
 	}
@@ -38,11 +40,11 @@ func (x *Config) Sub(name string) *Config {
 //
 // Returns nil if config is nil.
 func (x *Config) Value(name string) interface{} {
-	value := x.v.Get(strings.Join(append(x.path, name), separator))
+	value := x.v.get().Get(strings.Join(append(x.path, name), separator))
 	if value != nil || x.defaultPath == nil {
 		return value
 	}
-	return x.v.Get(strings.Join(append(x.defaultPath, name), separator))
+	return x.v.get().Get(strings.Join(append(x.defaultPath, name), separator))
 }
 
 // SetDefault sets fallback config for missing values.
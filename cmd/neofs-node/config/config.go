@@ -2,9 +2,15 @@ package config
 
 import (
 	"fmt"
+	"path"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
+	utilConfig "github.com/nspcc-dev/neofs-node/pkg/util/config"
 	"github.com/spf13/viper"
 )
 
@@ -15,14 +21,30 @@ import (
 // leaves are named configuration values.
 // Names are of string type.
 type Config struct {
-	v *viper.Viper
+	v *values
 
 	opts opts
 
+	handlers *changeHandlers
+
 	defaultPath []string
 	path        []string
 }
 
+// values holds configuration values shared by the Config and all its
+// sub-sections. Reload replaces them at once.
+type values struct {
+	v atomic.Value // *viper.Viper
+}
+
+func (x *values) get() *viper.Viper {
+	return x.v.Load().(*viper.Viper)
+}
+
+func (x *values) set(v *viper.Viper) {
+	x.v.Store(v)
+}
+
 const separator = "."
 
 // Prm groups required parameters of the Config.
@@ -32,42 +54,187 @@ type Prm struct{}
 //
 // If file option is provided (WithConfigFile),
 // configuration values are read from it.
-// Otherwise, Config is a degenerate tree.
-func New(_ Prm, opts ...Option) *Config {
+// If directory option is provided (WithConfigDir),
+// configuration values from its files are merged
+// on top of the file ones.
+// ENV variables override values from both of them.
+//
+// If none of them is provided, configuration values
+// are read from ENV variables only. WithEnvOnly option
+// states it explicitly.
+//
+// Returns an error if WithEnvOnly is combined with file or
+// directory option, if configuration can't be read or, with
+// WithStrictValidation option, contains unknown keys.
+func New(_ Prm, opts ...Option) (*Config, error) {
+	o := defaultOpts()
+	for i := range opts {
+		opts[i](o)
+	}
+
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+
+	v, err := o.read()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = o.checkKeys(v); err != nil {
+		return nil, err
+	}
+
+	c := &Config{
+		v:        new(values),
+		opts:     *o,
+		handlers: new(changeHandlers),
+	}
+
+	c.v.set(v)
+
+	return c, nil
+}
+
+// read reads configuration values from the sources set in opts.
+func (o *opts) read() (*viper.Viper, error) {
 	v := viper.New()
 
 	v.SetEnvPrefix(internal.EnvPrefix)
 	v.AutomaticEnv()
 	v.SetEnvKeyReplacer(strings.NewReplacer(separator, internal.EnvSeparator))
 
-	o := defaultOpts()
-	for i := range opts {
-		opts[i](o)
-	}
-
 	if o.path != "" {
 		v.SetConfigFile(o.path)
 
 		err := v.ReadInConfig()
 		if err != nil {
-			panic(fmt.Errorf("failed to read config: %w", err))
+			return nil, fmt.Errorf("failed to read config: %w", err)
 		}
 	}
 
-	return &Config{
-		v:    v,
-		opts: *o,
+	if o.configDir != "" {
+		err := utilConfig.ReadConfigDir(v, o.configDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config dir: %w", err)
+		}
 	}
+
+	return v, nil
 }
 
-// Reload reads configuration path if it was provided to New.
-func (x *Config) Reload() error {
-	if x.opts.path != "" {
-		err := x.v.ReadInConfig()
-		if err != nil {
-			return fmt.Errorf("rereading configuration file: %w", err)
+// checkKeys checks configuration keys against the schema if strict
+// validation is enabled.
+func (o *opts) checkKeys(v *viper.Viper) error {
+	if !o.strict {
+		return nil
+	}
+
+	var unknown []string
+
+loop:
+	for _, key := range v.AllKeys() {
+		for i := range o.schema {
+			if ok, _ := path.Match(o.schema[i], key); ok {
+				continue loop
+			}
 		}
+
+		unknown = append(unknown, key)
+	}
+
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown configuration keys: %s", strings.Join(unknown, ", "))
 	}
 
 	return nil
 }
+
+// ConfigFile returns the configuration file path provided to New.
+func (x *Config) ConfigFile() string {
+	return x.opts.path
+}
+
+// ConfigDir returns the configuration directory path provided to New.
+func (x *Config) ConfigDir() string {
+	return x.opts.configDir
+}
+
+// Reload rereads configuration file and directory if they were provided to
+// New and calls handlers registered via OnChange for the changed values.
+// With strict validation (WithStrictValidation) unknown keys are reported as
+// an error. Values are replaced only if they have been read and validated
+// successfully, otherwise the current ones are kept.
+func (x *Config) Reload() error {
+	v, err := x.opts.read()
+	if err != nil {
+		return fmt.Errorf("rereading configuration: %w", err)
+	}
+
+	if err = x.opts.checkKeys(v); err != nil {
+		return err
+	}
+
+	old := x.handlers.snapshot(x.v.get())
+
+	x.v.set(v)
+
+	x.handlers.notify(v, old)
+
+	return nil
+}
+
+// OnChange registers handler which is called by Reload if the value by name
+// has been changed. Name is relative to the Config sub-section.
+func (x *Config) OnChange(name string, f func()) {
+	x.handlers.add(strings.Join(append(x.path, name), separator), f)
+}
+
+// changeHandlers groups handlers of the configuration values changes by the
+// full value keys.
+type changeHandlers struct {
+	mtx sync.Mutex
+	m   map[string][]func()
+}
+
+func (h *changeHandlers) add(key string, f func()) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.m == nil {
+		h.m = make(map[string][]func())
+	}
+
+	h.m[key] = append(h.m[key], f)
+}
+
+// snapshot returns current values of the keys with registered handlers.
+func (h *changeHandlers) snapshot(v *viper.Viper) map[string]interface{} {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	res := make(map[string]interface{}, len(h.m))
+	for key := range h.m {
+		res[key] = v.Get(key)
+	}
+
+	return res
+}
+
+// notify calls handlers of the keys which values differ from the snapshot.
+func (h *changeHandlers) notify(v *viper.Viper, old map[string]interface{}) {
+	var fs []func()
+
+	h.mtx.Lock()
+	for key, handlers := range h.m {
+		if !reflect.DeepEqual(old[key], v.Get(key)) {
+			fs = append(fs, handlers...)
+		}
+	}
+	h.mtx.Unlock()
+
+	for i := range fs {
+		fs[i]()
+	}
+}
@@ -0,0 +1,168 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigDir(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "config.yaml")
+	cfgDir := filepath.Join(dir, "config.d")
+
+	require.NoError(t, os.Mkdir(cfgDir, 0700))
+	require.NoError(t, os.WriteFile(cfgFile, []byte("section:\n  file: 1\n  value: file\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "01.yaml"), []byte("section:\n  value: dir\n"), 0600))
+
+	os.Clearenv()
+
+	c, err := config.New(config.Prm{},
+		config.WithConfigFile(cfgFile),
+		config.WithConfigDir(cfgDir),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, cfgFile, c.ConfigFile())
+	require.Equal(t, cfgDir, c.ConfigDir())
+	require.Equal(t, cfgDir, c.Sub("section").ConfigDir())
+
+	require.Equal(t, "dir", config.String(c.Sub("section"), "value"))
+	require.EqualValues(t, 1, config.Int(c.Sub("section"), "file"))
+
+	empty, err := config.New(config.Prm{}, config.WithEnvOnly())
+	require.NoError(t, err)
+	require.Empty(t, empty.ConfigFile())
+	require.Empty(t, empty.ConfigDir())
+}
+
+func TestConfig_Reload(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "config.json")
+	cfgDir := filepath.Join(dir, "config.d")
+
+	require.NoError(t, os.Mkdir(cfgDir, 0700))
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`{"section": {"a": "1", "b": "1"}}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "01.yaml"), []byte("section:\n  c: 1\n"), 0600))
+
+	os.Clearenv()
+
+	c, err := config.New(config.Prm{},
+		config.WithConfigFile(cfgFile),
+		config.WithConfigDir(cfgDir),
+	)
+	require.NoError(t, err)
+
+	var aChanged, bChanged, cChanged int
+	sub := c.Sub("section")
+	sub.OnChange("a", func() { aChanged++ })
+	sub.OnChange("b", func() { bChanged++ })
+	c.OnChange("section.c", func() { cChanged++ })
+
+	require.NoError(t, c.Reload())
+	require.Zero(t, aChanged)
+	require.Zero(t, bChanged)
+	require.Zero(t, cChanged)
+
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`{"section": {"a": "2", "b": "1"}}`), 0600))
+	require.NoError(t, os.Remove(filepath.Join(cfgDir, "01.yaml")))
+
+	require.NoError(t, c.Reload())
+	require.Equal(t, "2", config.String(sub, "a"))
+	require.Empty(t, config.String(sub, "c"))
+	require.Equal(t, 1, aChanged)
+	require.Zero(t, bChanged)
+	require.Equal(t, 1, cChanged)
+
+	t.Run("dir only", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "01.yaml"), []byte("value: 1\n"), 0600))
+
+		c, err := config.New(config.Prm{}, config.WithConfigDir(cfgDir))
+		require.NoError(t, err)
+		require.Equal(t, "1", config.String(c, "value"))
+
+		var changed int
+		c.OnChange("value", func() { changed++ })
+
+		require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "01.yaml"), []byte("other: 1\n"), 0600))
+		require.NoError(t, c.Reload())
+		require.Empty(t, config.String(c, "value"))
+		require.Equal(t, 1, changed)
+	})
+}
+
+func TestConfig_StrictValidation(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "config.yaml")
+
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`
+object:
+  put:
+    pool_size_remote: 1
+storage:
+  shard:
+    0:
+      path: /a
+`), 0600))
+
+	os.Clearenv()
+
+	schema := []string{"object.put.pool_size_remote", "storage.shard.*.path"}
+
+	c, err := config.New(config.Prm{}, config.WithConfigFile(cfgFile), config.WithStrictValidation(schema))
+	require.NoError(t, err)
+
+	var changed int
+	c.OnChange("object.put.pool_size_remote", func() { changed++ })
+
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`
+object:
+  put:
+    poolsize_remote: 1
+storage:
+  shard:
+    0:
+      path: /a
+      pth: /b
+`), 0600))
+
+	_, err = config.New(config.Prm{}, config.WithConfigFile(cfgFile))
+	require.NoError(t, err)
+
+	const errMsg = "unknown configuration keys: object.put.poolsize_remote, storage.shard.0.pth"
+
+	_, err = config.New(config.Prm{}, config.WithConfigFile(cfgFile), config.WithStrictValidation(schema))
+	require.EqualError(t, err, errMsg)
+
+	// rejected configuration must not be applied
+	require.EqualError(t, c.Reload(), errMsg)
+	require.EqualValues(t, 1, config.Int(c.Sub("object").Sub("put"), "pool_size_remote"))
+	require.Empty(t, config.String(c.Sub("storage").Sub("shard").Sub("0"), "pth"))
+	require.Zero(t, changed)
+}
+
+func TestConfig_EnvOnly(t *testing.T) {
+	const value = "some value"
+
+	os.Clearenv()
+	require.NoError(t, os.Setenv(internal.Env("section", "name"), value))
+
+	c, err := config.New(config.Prm{}, config.WithEnvOnly())
+	require.NoError(t, err)
+	require.Equal(t, value, config.String(c.Sub("section"), "name"))
+
+	// no file and directory means ENV-only configuration too
+	c, err = config.New(config.Prm{})
+	require.NoError(t, err)
+	require.Equal(t, value, config.String(c.Sub("section"), "name"))
+
+	_, err = config.New(config.Prm{}, config.WithEnvOnly(), config.WithConfigFile("test/config.yaml"))
+	require.Error(t, err)
+
+	_, err = config.New(config.Prm{}, config.WithEnvOnly(), config.WithConfigDir("test"))
+	require.Error(t, err)
+}
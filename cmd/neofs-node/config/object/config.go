@@ -1,7 +1,13 @@
 package objectconfig
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 )
 
 // PutConfig is a wrapper over "put" config section which provides access
@@ -15,9 +21,20 @@ const (
 
 	putSubsection = "put"
 
+	deleteSubsection = "delete"
+
 	// PutPoolSizeDefault is a default value of routine pool size to
 	// process object.Put requests in object service.
 	PutPoolSizeDefault = 10
+
+	// PutPoolSizeLocalDefault is a default value of routine pool size to
+	// save objects locally while processing object.Put requests in object
+	// service. Zero means the number of the local saves is not limited.
+	PutPoolSizeLocalDefault = 0
+
+	// TombstoneLifetimeDefault is a default lifetime of the tombstones
+	// created by object service in NeoFS epochs.
+	TombstoneLifetimeDefault = 5
 )
 
 // Put returns structure that provides access to "put" subsection of
@@ -39,3 +56,123 @@ func (g PutConfig) PoolSizeRemote() int {
 
 	return PutPoolSizeDefault
 }
+
+// PoolSizeLocal returns the value of "pool_size_local" config parameter.
+//
+// Returns PutPoolSizeLocalDefault if the value is not a positive number.
+func (g PutConfig) PoolSizeLocal() int {
+	v := config.Int(g.cfg, "pool_size_local")
+	if v > 0 {
+		return int(v)
+	}
+
+	return PutPoolSizeLocalDefault
+}
+
+// TombstoneLifetime returns the value of "tombstone_lifetime" config
+// parameter from "delete" subsection of "object" section.
+//
+// Returns TombstoneLifetimeDefault if the value is not a positive number.
+func TombstoneLifetime(c *config.Config) uint64 {
+	v := config.UintSafe(c.Sub(subsection).Sub(deleteSubsection), "tombstone_lifetime")
+	if v > 0 {
+		return v
+	}
+
+	return TombstoneLifetimeDefault
+}
+
+// ContainerTombstoneLifetimes returns the container tombstone lifetimes
+// from the list of "container_tombstone_lifetime" config parameter in
+// "delete" subsection of "object" section. Each list element has "container"
+// ID and "lifetime" in NeoFS epochs, elements with non-positive lifetime are
+// ignored.
+//
+// Throws panic if container ID is invalid.
+func ContainerTombstoneLifetimes(c *config.Config) map[cid.ID]uint64 {
+	res := make(map[cid.ID]uint64)
+
+	sub := c.Sub(subsection).Sub(deleteSubsection).Sub("container_tombstone_lifetime")
+	for i := 0; ; i++ {
+		s := sub.Sub(strconv.Itoa(i))
+
+		cnrStr := config.StringSafe(s, "container")
+		if cnrStr == "" {
+			break
+		}
+
+		var cnr cid.ID
+		if err := cnr.DecodeString(cnrStr); err != nil {
+			panic(fmt.Errorf("invalid container ID in container_tombstone_lifetime #%d: %w", i, err))
+		}
+
+		if v := config.UintSafe(s, "lifetime"); v > 0 {
+			res[cnr] = v
+		}
+	}
+
+	return res
+}
+
+// TombstoneLifetimeForContainer returns tombstone lifetime of the given
+// container from ContainerTombstoneLifetimes falling back to TombstoneLifetime.
+func TombstoneLifetimeForContainer(c *config.Config, cnr cid.ID) uint64 {
+	if v, ok := ContainerTombstoneLifetimes(c)[cnr]; ok {
+		return v
+	}
+
+	return TombstoneLifetime(c)
+}
+
+// EffectiveConfig groups resolved values of "object" section
+// including defaults of the missing ones.
+type EffectiveConfig struct {
+	PutPoolSizeRemote           int
+	PutPoolSizeLocal            int
+	TombstoneLifetime           uint64
+	ContainerTombstoneLifetimes map[cid.ID]uint64
+}
+
+// Dump returns resolved values of "object" section.
+func Dump(c *config.Config) (res EffectiveConfig, err error) {
+	defer func() {
+		// some getters panic on invalid values
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid object config: %v", r)
+		}
+	}()
+
+	put := Put(c)
+
+	return EffectiveConfig{
+		PutPoolSizeRemote:           put.PoolSizeRemote(),
+		PutPoolSizeLocal:            put.PoolSizeLocal(),
+		TombstoneLifetime:           TombstoneLifetime(c),
+		ContainerTombstoneLifetimes: ContainerTombstoneLifetimes(c),
+	}, nil
+}
+
+// String implements fmt.Stringer.
+func (x EffectiveConfig) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "put.pool_size_remote: %d\n", x.PutPoolSizeRemote)
+	fmt.Fprintf(&sb, "put.pool_size_local: %d\n", x.PutPoolSizeLocal)
+	fmt.Fprintf(&sb, "delete.tombstone_lifetime: %d\n", x.TombstoneLifetime)
+
+	cnrs := make([]string, 0, len(x.ContainerTombstoneLifetimes))
+	lifetimes := make(map[string]uint64, len(x.ContainerTombstoneLifetimes))
+	for cnr, v := range x.ContainerTombstoneLifetimes {
+		s := cnr.EncodeToString()
+		cnrs = append(cnrs, s)
+		lifetimes[s] = v
+	}
+
+	sort.Strings(cnrs)
+
+	for i := range cnrs {
+		fmt.Fprintf(&sb, "delete.container_tombstone_lifetime[%s]: %d\n", cnrs[i], lifetimes[cnrs[i]])
+	}
+
+	return sb.String()
+}
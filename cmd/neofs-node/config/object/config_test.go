@@ -1,11 +1,15 @@
 package objectconfig_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,12 +18,46 @@ func TestObjectSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeRemote())
+		require.Equal(t, objectconfig.PutPoolSizeLocalDefault, objectconfig.Put(empty).PoolSizeLocal())
+		require.EqualValues(t, objectconfig.TombstoneLifetimeDefault, objectconfig.TombstoneLifetime(empty))
+		require.Empty(t, objectconfig.ContainerTombstoneLifetimes(empty))
+		require.EqualValues(t, objectconfig.TombstoneLifetimeDefault, objectconfig.TombstoneLifetimeForContainer(empty, cidtest.ID()))
+
+		dump, err := objectconfig.Dump(empty)
+		require.NoError(t, err)
+		require.Equal(t, objectconfig.EffectiveConfig{
+			PutPoolSizeRemote:           objectconfig.PutPoolSizeDefault,
+			PutPoolSizeLocal:            objectconfig.PutPoolSizeLocalDefault,
+			TombstoneLifetime:           objectconfig.TombstoneLifetimeDefault,
+			ContainerTombstoneLifetimes: map[cid.ID]uint64{},
+		}, dump)
+		require.Equal(t, "put.pool_size_remote: 10\n"+
+			"put.pool_size_local: 0\n"+
+			"delete.tombstone_lifetime: 5\n", dump.String())
 	})
 
 	const path = "../../../../config/example/node"
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 100, objectconfig.Put(c).PoolSizeRemote())
+		require.Equal(t, 200, objectconfig.Put(c).PoolSizeLocal())
+		require.EqualValues(t, 10, objectconfig.TombstoneLifetime(c))
+
+		var cnr cid.ID
+		require.NoError(t, cnr.DecodeString("A9p8zg82EejFEoTPEscBj11LTEak6kMJAiYGpHeotW5H"))
+		require.Equal(t, map[cid.ID]uint64{cnr: 2}, objectconfig.ContainerTombstoneLifetimes(c))
+		require.EqualValues(t, 2, objectconfig.TombstoneLifetimeForContainer(c, cnr))
+		require.EqualValues(t, 10, objectconfig.TombstoneLifetimeForContainer(c, cidtest.ID()))
+
+		dump, err := objectconfig.Dump(c)
+		require.NoError(t, err)
+		require.Equal(t, objectconfig.EffectiveConfig{
+			PutPoolSizeRemote:           100,
+			PutPoolSizeLocal:            200,
+			TombstoneLifetime:           10,
+			ContainerTombstoneLifetimes: map[cid.ID]uint64{cnr: 2},
+		}, dump)
+		require.Contains(t, dump.String(), "delete.container_tombstone_lifetime["+cnr.EncodeToString()+"]: 2\n")
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -27,4 +65,23 @@ func TestObjectSection(t *testing.T) {
 	t.Run("ENV", func(t *testing.T) {
 		configtest.ForEnvFileType(path, fileConfigTest)
 	})
+
+	t.Run("invalid", func(t *testing.T) {
+		p := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(p, []byte(`
+object:
+  delete:
+    container_tombstone_lifetime:
+      - container: invalid
+        lifetime: 1
+`), 0600))
+
+		os.Clearenv()
+
+		c, err := config.New(config.Prm{}, config.WithConfigFile(p))
+		require.NoError(t, err)
+
+		_, err = objectconfig.Dump(c)
+		require.Error(t, err)
+	})
 }
@@ -1,13 +1,29 @@
 package config
 
+import "errors"
+
 type opts struct {
-	path string
+	path      string
+	configDir string
+
+	envOnly bool
+
+	strict bool
+	schema []string
 }
 
 func defaultOpts() *opts {
 	return new(opts)
 }
 
+func (o *opts) validate() error {
+	if o.envOnly && (o.path != "" || o.configDir != "") {
+		return errors.New("ENV-only configuration can't be used with configuration file or directory")
+	}
+
+	return nil
+}
+
 // Option allows to set an optional parameter of the Config.
 type Option func(*opts)
 
@@ -18,3 +34,33 @@ func WithConfigFile(path string) Option {
 		o.path = path
 	}
 }
+
+// WithConfigDir returns an option to set the system path
+// to the directory with configuration files.
+func WithConfigDir(path string) Option {
+	return func(o *opts) {
+		o.configDir = path
+	}
+}
+
+// WithStrictValidation returns an option to check that all configuration
+// keys read from the file and directory match at least one of the schema
+// patterns. Patterns use path.Match syntax, note that '*' also matches
+// key separators, e.g. "storage.shard.*.path" matches "storage.shard.0.path".
+func WithStrictValidation(schema []string) Option {
+	return func(o *opts) {
+		o.strict = true
+		o.schema = schema
+	}
+}
+
+// WithEnvOnly returns an option to read configuration
+// from ENV variables only, without any file or directory.
+// It is the default if neither file nor directory is set,
+// the option states it explicitly and makes New fail
+// if any of them is set.
+func WithEnvOnly() Option {
+	return func(o *opts) {
+		o.envOnly = true
+	}
+}
@@ -13,7 +13,7 @@ func fromFile(path string) *config.Config {
 
 	os.Clearenv() // ENVs have priority over config files, so we do this in tests
 
-	return config.New(p,
+	return mustNew(p,
 		config.WithConfigFile(path),
 	)
 }
@@ -23,7 +23,16 @@ func fromEnvFile(path string) *config.Config {
 
 	loadEnv(path) // github.com/joho/godotenv can do that as well
 
-	return config.New(p)
+	return mustNew(p, config.WithEnvOnly())
+}
+
+func mustNew(p config.Prm, opts ...config.Option) *config.Config {
+	c, err := config.New(p, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
 }
 
 func forEachFile(paths []string, f func(*config.Config)) {
@@ -51,7 +60,7 @@ func ForEnvFileType(pref string, f func(*config.Config)) {
 func EmptyConfig() *config.Config {
 	var p config.Prm
 
-	return config.New(p)
+	return mustNew(p, config.WithEnvOnly())
 }
 
 // loadEnv reads .env file, parses `X=Y` records and sets OS ENVs.
@@ -36,8 +36,11 @@ func fatalOnErrDetails(details string, err error) {
 
 func main() {
 	configFile := flag.String("config", "", "path to config")
+	configDir := flag.String("config-dir", "", "path to config directory")
 	versionFlag := flag.Bool("version", false, "neofs node version")
 	dryRunFlag := flag.Bool("check", false, "validate configuration and exit")
+	strictFlag := flag.Bool("strict-config", false, "fail on unknown configuration keys")
+	envOnlyFlag := flag.Bool("env-only", false, "read configuration from ENV variables only")
 	flag.Parse()
 
 	if *versionFlag {
@@ -46,9 +49,24 @@ func main() {
 		os.Exit(SuccessReturnCode)
 	}
 
-	appCfg := config.New(config.Prm{}, config.WithConfigFile(*configFile))
+	var cfgOpts []config.Option
+	if *envOnlyFlag {
+		cfgOpts = append(cfgOpts, config.WithEnvOnly())
+	}
+	if *configFile != "" {
+		cfgOpts = append(cfgOpts, config.WithConfigFile(*configFile))
+	}
+	if *configDir != "" {
+		cfgOpts = append(cfgOpts, config.WithConfigDir(*configDir))
+	}
+	if *strictFlag {
+		cfgOpts = append(cfgOpts, config.WithStrictValidation(configSchema))
+	}
+
+	appCfg, err := config.New(config.Prm{}, cfgOpts...)
+	fatalOnErr(err)
 
-	err := validateConfig(appCfg)
+	err = validateConfig(appCfg)
 	fatalOnErr(err)
 
 	if *dryRunFlag {
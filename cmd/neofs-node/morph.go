@@ -31,6 +31,10 @@ const (
 
 	// amount of tries(blocks) before notary deposit timeout.
 	notaryDepositRetriesAmount
+
+	// morphCloseTimeout is a time to wait for the sidechain calls
+	// in progress on shutdown.
+	morphCloseTimeout = 10 * time.Second
 )
 
 func initMorphComponents(c *cfg) {
@@ -62,7 +66,14 @@ func initMorphComponents(c *cfg) {
 		fatalOnErr(err)
 	}
 
-	c.onShutdown(cli.Close)
+	c.onShutdown(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), morphCloseTimeout)
+		defer cancel()
+
+		if err := cli.Close(ctx); err != nil {
+			c.log.Warn("could not close sidechain client gracefully", zap.Error(err))
+		}
+	})
 
 	if err := cli.SetGroupSignerScope(); err != nil {
 		c.log.Info("failed to set group signer scope, continue with Global", zap.Error(err))
@@ -8,6 +8,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectGRPC "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
 	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -99,12 +100,17 @@ func (s *objectSvc) GetRangeHash(ctx context.Context, req *object.GetRangeHashRe
 
 type delNetInfo struct {
 	netmap.State
-	tsLifetime uint64
+	tsLifetime    uint64
+	cnrTSLifetime map[cid.ID]uint64
 
 	cfg *cfg
 }
 
-func (i *delNetInfo) TombstoneLifetime() (uint64, error) {
+func (i *delNetInfo) TombstoneLifetime(cnr cid.ID) (uint64, error) {
+	if v, ok := i.cnrTSLifetime[cnr]; ok {
+		return v, nil
+	}
+
 	return i.tsLifetime, nil
 }
 
@@ -262,7 +268,7 @@ func initObjectService(c *cfg) {
 		putsvc.WithNetworkMapSource(c.netMapSource),
 		putsvc.WithNetmapKeys(c),
 		putsvc.WithNetworkState(c.cfgNetmap.state),
-		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote),
+		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote, c.cfgObject.pool.putLocal),
 		putsvc.WithLogger(c.log),
 	)
 
@@ -315,8 +321,9 @@ func initObjectService(c *cfg) {
 		deletesvc.WithSearchService(sSearch),
 		deletesvc.WithPutService(sPut),
 		deletesvc.WithNetworkInfo(&delNetInfo{
-			State:      c.cfgNetmap.state,
-			tsLifetime: 5,
+			State:         c.cfgNetmap.state,
+			tsLifetime:    objectconfig.TombstoneLifetime(c.appCfg),
+			cnrTSLifetime: objectconfig.ContainerTombstoneLifetimes(c.appCfg),
 
 			cfg: c,
 		}),
@@ -14,6 +14,117 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 )
 
+// configSchema lists patterns of the known storage node configuration keys
+// used for strict configuration validation (see config.WithStrictValidation).
+var configSchema = []string{
+	"logger.level",
+
+	"pprof.enabled",
+	"pprof.address",
+	"pprof.shutdown_timeout",
+
+	"prometheus.enabled",
+	"prometheus.address",
+	"prometheus.shutdown_timeout",
+
+	"control.authorized_keys",
+	"control.grpc.endpoint",
+
+	"contracts.balance",
+	"contracts.container",
+	"contracts.netmap",
+	"contracts.proxy",
+	"contracts.reputation",
+
+	"morph.dial_timeout",
+	"morph.cache_ttl",
+	"morph.switch_interval",
+	"morph.rpc_endpoint",
+
+	"apiclient.dial_timeout",
+	"apiclient.stream_timeout",
+	"apiclient.reconnect_timeout",
+	"apiclient.allow_external",
+
+	"policer.head_timeout",
+
+	"replicator.pool_size",
+	"replicator.put_timeout",
+
+	"object.put.pool_size_remote",
+	"object.put.pool_size_local",
+	"object.delete.tombstone_lifetime",
+	"object.delete.container_tombstone_lifetime",
+	"object.delete.container_tombstone_lifetime.*.container",
+	"object.delete.container_tombstone_lifetime.*.lifetime",
+
+	"tree.enabled",
+	"tree.cache_size",
+	"tree.replication_channel_capacity",
+	"tree.replication_worker_count",
+	"tree.replication_timeout",
+	"tree.sync_interval",
+
+	"grpc",
+	"grpc.*.endpoint",
+	"grpc.*.tls.enabled",
+	"grpc.*.tls.certificate",
+	"grpc.*.tls.key",
+	"grpc.*.tls.use_insecure_crypto",
+
+	"node.key",
+	"node.wallet.path",
+	"node.wallet.address",
+	"node.wallet.password",
+	"node.addresses",
+	"node.attribute_*",
+	"node.relay",
+	"node.persistent_sessions.path",
+	"node.persistent_state.path",
+	"node.subnet.entries",
+	"node.subnet.exit_zero",
+	"node.notification.enabled",
+	"node.notification.endpoint",
+	"node.notification.timeout",
+	"node.notification.default_topic",
+	"node.notification.certificate",
+	"node.notification.key",
+	"node.notification.ca",
+
+	"storage.shard_pool_size",
+	"storage.shard_ro_error_threshold",
+	"storage.shard.*.mode",
+	"storage.shard.*.resync_metabase",
+	"storage.shard.*.compress",
+	"storage.shard.*.compression_exclude_content_types",
+	"storage.shard.*.small_object_size",
+	"storage.shard.*.blobstor",
+	"storage.shard.*.gc.remover_batch_size",
+	"storage.shard.*.gc.remover_sleep_interval",
+	"storage.shard.*.metabase.path",
+	"storage.shard.*.metabase.perm",
+	"storage.shard.*.metabase.max_batch_size",
+	"storage.shard.*.metabase.max_batch_delay",
+	"storage.shard.*.pilorama.path",
+	"storage.shard.*.pilorama.perm",
+	"storage.shard.*.pilorama.no_sync",
+	"storage.shard.*.pilorama.max_batch_size",
+	"storage.shard.*.pilorama.max_batch_delay",
+	"storage.shard.*.writecache.enabled",
+	"storage.shard.*.writecache.path",
+	"storage.shard.*.writecache.capacity",
+	"storage.shard.*.writecache.no_sync",
+	"storage.shard.*.writecache.small_object_size",
+	"storage.shard.*.writecache.max_object_size",
+	"storage.shard.*.writecache.workers_number",
+
+	// deprecated keys, they are ignored by the node but still
+	// present in the configurations of the existing deployments
+	"grpc.num",
+	"storage.shard_num",
+	"storage.shard.*.writecache.memcache_capacity",
+}
+
 // validateConfig validates storage node configuration.
 func validateConfig(c *config.Config) error {
 	// logger configuration validation
@@ -26,13 +26,30 @@ func TestValidate(t *testing.T) {
 	t.Run("mainnet", func(t *testing.T) {
 		os.Clearenv() // ENVs have priority over config files, so we do this in tests
 		p := filepath.Join(exampleConfigPrefix, "mainnet/config.yml")
-		c := config.New(config.Prm{}, config.WithConfigFile(p))
+		c, err := config.New(config.Prm{}, config.WithConfigFile(p))
+		require.NoError(t, err)
 		require.NoError(t, validateConfig(c))
 	})
 	t.Run("testnet", func(t *testing.T) {
 		os.Clearenv() // ENVs have priority over config files, so we do this in tests
 		p := filepath.Join(exampleConfigPrefix, "testnet/config.yml")
-		c := config.New(config.Prm{}, config.WithConfigFile(p))
+		c, err := config.New(config.Prm{}, config.WithConfigFile(p))
+		require.NoError(t, err)
 		require.NoError(t, validateConfig(c))
 	})
+
+	t.Run("strict", func(t *testing.T) {
+		for _, p := range []string{
+			"example/node.yaml",
+			"example/node.json",
+			"mainnet/config.yml",
+			"testnet/config.yml",
+		} {
+			os.Clearenv() // ENVs have priority over config files, so we do this in tests
+			_, err := config.New(config.Prm{},
+				config.WithConfigFile(filepath.Join(exampleConfigPrefix, p)),
+				config.WithStrictValidation(configSchema))
+			require.NoError(t, err, p)
+		}
+	})
 }
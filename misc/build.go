@@ -3,19 +3,89 @@ package misc
 import (
 	"fmt"
 	"runtime"
+	"runtime/debug"
+	"strings"
 )
 
 // These variables are changed in compile time.
 var (
 	// Version is an application version.
 	Version = "dev"
+
+	// Commit is a VCS revision the application is built from.
+	Commit = ""
+
+	// BuildDate is an application build date.
+	BuildDate = ""
 )
 
+// dependencies are modules whose versions are reported in the build info.
+var dependencies = []string{
+	"github.com/nspcc-dev/neo-go",
+	"github.com/nspcc-dev/neofs-api-go/v2",
+	"github.com/nspcc-dev/neofs-sdk-go",
+}
+
+// Info is a structured information about this binary.
+type Info struct {
+	Component    string            `json:"component"`
+	Version      string            `json:"version"`
+	Commit       string            `json:"commit,omitempty"`
+	BuildDate    string            `json:"build_date,omitempty"`
+	GoVersion    string            `json:"go_version"`
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+// NewInfo returns information about this binary. Versions of the main
+// dependencies are filled only if the binary is built with module support.
+func NewInfo(component string) Info {
+	info := Info{
+		Component: component,
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range bi.Deps {
+			for i := range dependencies {
+				if dep.Path == dependencies[i] {
+					if info.Dependencies == nil {
+						info.Dependencies = make(map[string]string, len(dependencies))
+					}
+					info.Dependencies[dep.Path] = dep.Version
+				}
+			}
+		}
+	}
+
+	return info
+}
+
+// String returns human-readable information about this binary.
+func (x Info) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s\nVersion: %s \n", x.Component, x.Version)
+	if x.Commit != "" {
+		fmt.Fprintf(&sb, "Commit: %s\n", x.Commit)
+	}
+	if x.BuildDate != "" {
+		fmt.Fprintf(&sb, "BuildDate: %s\n", x.BuildDate)
+	}
+	fmt.Fprintf(&sb, "GoVersion: %s\n", x.GoVersion)
+
+	for _, dep := range dependencies {
+		if v, ok := x.Dependencies[dep]; ok {
+			fmt.Fprintf(&sb, "%s: %s\n", dep, v)
+		}
+	}
+
+	return sb.String()
+}
+
 // BuildInfo returns human-readable information about this binary.
 func BuildInfo(component string) string {
-	return fmt.Sprintf("%s\nVersion: %s \nGoVersion: %s\n",
-		component,
-		Version,
-		runtime.Version(),
-	)
+	return NewInfo(component).String()
 }
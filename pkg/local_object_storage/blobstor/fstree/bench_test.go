@@ -0,0 +1,69 @@
+package fstree
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func BenchmarkFSTree_Compression(b *testing.B) {
+	for _, size := range []uint64{1024, 32 * 1024, 1024 * 1024} {
+		for _, compress := range []bool{false, true} {
+			b.Run(fmt.Sprintf("size=%d,compress=%t", size, compress), func(b *testing.B) {
+				fst := New(WithPath(b.TempDir()), WithDepth(2), WithDirNameLen(2), WithNoSync(true))
+				fst.SetCompressor(&compression.Config{Enabled: compress})
+				require.NoError(b, fst.Config.Init())
+				require.NoError(b, fst.Open(false))
+				require.NoError(b, fst.Init())
+
+				// zeroed payload to make compression effective
+				obj := blobstortest.NewObject(size)
+				obj.SetPayload(make([]byte, len(obj.Payload())))
+
+				data, err := obj.Marshal()
+				require.NoError(b, err)
+
+				addr := objectAddress(obj)
+
+				b.Run("put", func(b *testing.B) {
+					b.ReportAllocs()
+					b.SetBytes(int64(len(data)))
+					for i := 0; i < b.N; i++ {
+						_, err := fst.Put(common.PutPrm{Address: addr, RawData: data})
+						if err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+
+				b.Run("get", func(b *testing.B) {
+					b.ReportAllocs()
+					b.SetBytes(int64(len(data)))
+					for i := 0; i < b.N; i++ {
+						_, err := fst.Get(common.GetPrm{Address: addr})
+						if err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+			})
+		}
+	}
+}
+
+func objectAddress(obj *objectSDK.Object) oid.Address {
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+
+	var addr oid.Address
+	addr.SetObject(id)
+	addr.SetContainer(cnr)
+
+	return addr
+}
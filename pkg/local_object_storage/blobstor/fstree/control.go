@@ -12,6 +12,10 @@ func (t *FSTree) Open(ro bool) error {
 
 // Init implements common.Storage.
 func (t *FSTree) Init() error {
+	if err := t.checkDepth(); err != nil {
+		return err
+	}
+
 	return util.MkdirAllX(t.RootPath, t.Permissions)
 }
 
@@ -2,6 +2,7 @@ package fstree
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -28,10 +29,44 @@ type FSTree struct {
 	Depth      uint64
 	DirNameLen int
 
-	noSync   bool
-	readOnly bool
+	durability DurabilityMode
+	encoding   AddressEncoding
+	readOnly   bool
+
+	duCache diskUsageCache
 }
 
+// DurabilityMode defines how FSTree writes are persisted to the disk.
+type DurabilityMode uint8
+
+const (
+	// DurabilityData makes FSTree sync object file data on write. This is the
+	// default mode.
+	DurabilityData DurabilityMode = iota
+	// DurabilityNone makes FSTree write object files without syncing, so
+	// written data can be lost on power failure.
+	DurabilityNone
+	// DurabilityDataDir makes FSTree sync both object file data and the
+	// parent directory on write, so that the directory entry of a new file
+	// also survives power failure.
+	DurabilityDataDir
+)
+
+// AddressEncoding defines how object addresses are encoded in the FSTree
+// file paths.
+type AddressEncoding uint8
+
+const (
+	// AddressEncodingBase58 encodes object and container IDs in base58. This
+	// is the default encoding. Paths of different objects can collide on the
+	// case-insensitive filesystems.
+	AddressEncodingBase58 AddressEncoding = iota
+	// AddressEncodingHex encodes object and container IDs in lowercase hex,
+	// so paths of different objects never collide on the case-insensitive
+	// filesystems. Paths are longer than the base58 ones.
+	AddressEncodingHex
+)
+
 // Info groups the information about file storage.
 type Info struct {
 	// Permission bits of the root directory.
@@ -67,24 +102,120 @@ func New(opts ...Option) *FSTree {
 	return f
 }
 
+// checkDepth checks that directory levels fit into the object ID part of the
+// stringified address leaving non-empty file name, so that paths of different
+// objects can't collide.
+func (t *FSTree) checkDepth() error {
+	if t.Depth == 0 {
+		return nil
+	}
+
+	if t.DirNameLen <= 0 {
+		return fmt.Errorf("invalid directory name length %d", t.DirNameLen)
+	}
+
+	// Base58-encoded object ID is at least sha256.Size characters long.
+	if t.Depth > uint64(sha256.Size-1)/uint64(t.DirNameLen) {
+		return fmt.Errorf("depth %d with directory name length %d exceeds object ID length",
+			t.Depth, t.DirNameLen)
+	}
+
+	return nil
+}
+
+// addressSeparator separates object and container IDs in the stringified
+// address.
+const addressSeparator = "."
+
+// ErrInvalidAddressPath is returned when the path of the file in FSTree does
+// not correspond to any object address, e.g. if the directory layout is
+// corrupted.
+var ErrInvalidAddressPath = errors.New("invalid object address path")
+
+// encode returns address stringified according to the encoding.
+func (e AddressEncoding) encode(addr oid.Address) string {
+	if e == AddressEncodingHex {
+		return stringifyAddressHex(addr)
+	}
+
+	return stringifyAddress(addr)
+}
+
+// decode decodes the address stringified by encode.
+func (e AddressEncoding) decode(s string) (*oid.Address, error) {
+	if e == AddressEncodingHex {
+		return addressFromHexString(s)
+	}
+
+	return addressFromString(s)
+}
+
 func stringifyAddress(addr oid.Address) string {
-	return addr.Object().EncodeToString() + "." + addr.Container().EncodeToString()
+	return addr.Object().EncodeToString() + addressSeparator + addr.Container().EncodeToString()
 }
 
+// addressFromString decodes the address stringified by stringifyAddress.
+// Returns ErrInvalidAddressPath describing the problem if s is not
+// a stringified address.
 func addressFromString(s string) (*oid.Address, error) {
-	ss := strings.SplitN(s, ".", 2)
+	ss := strings.Split(s, addressSeparator)
 	if len(ss) != 2 {
-		return nil, errors.New("invalid address")
+		return nil, fmt.Errorf("%w: %d segments instead of 2 in %s", ErrInvalidAddressPath, len(ss), s)
 	}
 
 	var obj oid.ID
 	if err := obj.DecodeString(ss[0]); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: invalid object ID %s: %v", ErrInvalidAddressPath, ss[0], err)
 	}
 
 	var cnr cid.ID
 	if err := cnr.DecodeString(ss[1]); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: invalid container ID %s: %v", ErrInvalidAddressPath, ss[1], err)
+	}
+
+	var addr oid.Address
+	addr.SetObject(obj)
+	addr.SetContainer(cnr)
+
+	return &addr, nil
+}
+
+func stringifyAddressHex(addr oid.Address) string {
+	obj, cnr := addr.Object(), addr.Container()
+
+	return hex.EncodeToString(obj[:]) + addressSeparator + hex.EncodeToString(cnr[:])
+}
+
+// addressFromHexString is the same as addressFromString but decodes the
+// address stringified by stringifyAddressHex.
+func addressFromHexString(s string) (*oid.Address, error) {
+	ss := strings.Split(s, addressSeparator)
+	if len(ss) != 2 {
+		return nil, fmt.Errorf("%w: %d segments instead of 2 in %s", ErrInvalidAddressPath, len(ss), s)
+	}
+
+	if strings.ToLower(s) != s {
+		return nil, fmt.Errorf("%w: uppercase characters in %s", ErrInvalidAddressPath, s)
+	}
+
+	b, err := hex.DecodeString(ss[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid object ID %s: %v", ErrInvalidAddressPath, ss[0], err)
+	}
+
+	var obj oid.ID
+	if err = obj.Decode(b); err != nil {
+		return nil, fmt.Errorf("%w: invalid object ID %s: %v", ErrInvalidAddressPath, ss[0], err)
+	}
+
+	b, err = hex.DecodeString(ss[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid container ID %s: %v", ErrInvalidAddressPath, ss[1], err)
+	}
+
+	var cnr cid.ID
+	if err = cnr.Decode(b); err != nil {
+		return nil, fmt.Errorf("%w: invalid container ID %s: %v", ErrInvalidAddressPath, ss[1], err)
 	}
 
 	var addr oid.Address
@@ -99,6 +230,18 @@ func (t *FSTree) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
 	return common.IterateRes{}, t.iterate(0, []string{t.RootPath}, prm)
 }
 
+// IterateAddresses iterates over addresses of all stored objects without
+// reading their contents. Files which are not objects (e.g. temporary ones)
+// are skipped. The first error returned by f stops the iteration and is
+// returned.
+func (t *FSTree) IterateAddresses(f func(oid.Address) error) error {
+	return t.iterate(0, []string{t.RootPath}, common.IteratePrm{
+		LazyHandler: func(addr oid.Address, _ func() ([]byte, error)) error {
+			return f(addr)
+		},
+	})
+}
+
 func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm) error {
 	curName := strings.Join(curPath[1:], "")
 	des, err := os.ReadDir(filepath.Join(curPath...))
@@ -125,11 +268,11 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 			}
 		}
 
-		if depth != t.Depth {
+		if depth != t.Depth || !des[i].Type().IsRegular() {
 			continue
 		}
 
-		addr, err := addressFromString(curName + des[i].Name())
+		addr, err := t.encoding.decode(curName + des[i].Name())
 		if err != nil {
 			continue
 		}
@@ -170,7 +313,7 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 }
 
 func (t *FSTree) treePath(addr oid.Address) string {
-	sAddr := stringifyAddress(addr)
+	sAddr := t.encoding.encode(addr)
 
 	dirs := make([]string, 0, t.Depth+1+1) // 1 for root, 1 for file
 	dirs = append(dirs, t.RootPath)
@@ -252,7 +395,7 @@ func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
 
 func (t *FSTree) writeFlags() int {
 	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
-	if t.noSync {
+	if t.durability == DurabilityNone {
 		return flags
 	}
 	return flags | os.O_SYNC
@@ -269,6 +412,28 @@ func (t *FSTree) writeFile(p string, data []byte) error {
 	if err1 := f.Close(); err1 != nil && err == nil {
 		err = err1
 	}
+	if err == nil {
+		err = t.syncDir(filepath.Dir(p))
+	}
+	return err
+}
+
+// syncDir syncs directory with path p if it is required by the durability
+// mode.
+func (t *FSTree) syncDir(p string) error {
+	if t.durability != DurabilityDataDir {
+		return nil
+	}
+
+	d, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+
+	err = d.Sync()
+	if err1 := d.Close(); err1 != nil && err == nil {
+		err = err1
+	}
 	return err
 }
 
@@ -288,9 +453,15 @@ func (t *FSTree) PutStream(addr oid.Address, handler func(*os.File) error) error
 	if err != nil {
 		return err
 	}
-	defer f.Close()
 
-	return handler(f)
+	err = handler(f)
+	if err1 := f.Close(); err1 != nil && err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = t.syncDir(filepath.Dir(p))
+	}
+	return err
 }
 
 // Get returns an object from the storage by address.
@@ -319,6 +490,51 @@ func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
 	return common.GetRes{Object: obj, RawData: data}, err
 }
 
+// ErrCorruptedObject is returned by VerifyObject when the stored object does
+// not match its address.
+var ErrCorruptedObject = errors.New("corrupted object")
+
+// VerifyObject reads the object with the specified address and checks that
+// its header matches the object ID and its payload matches the payload
+// checksum from the header. ErrCorruptedObject is returned if the check
+// fails or the stored data can't be decoded.
+func (t *FSTree) VerifyObject(addr oid.Address) error {
+	p := t.treePath(addr)
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+		return err
+	}
+
+	data, err = t.Decompress(data)
+	if err != nil {
+		return fmt.Errorf("%w: decompress: %v", ErrCorruptedObject, err)
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return fmt.Errorf("%w: decode: %v", ErrCorruptedObject, err)
+	}
+
+	id, err := objectSDK.CalculateID(obj)
+	if err != nil {
+		return fmt.Errorf("%w: calculate ID: %v", ErrCorruptedObject, err)
+	}
+
+	if !id.Equals(addr.Object()) {
+		return fmt.Errorf("%w: header doesn't match object ID", ErrCorruptedObject)
+	}
+
+	if err := objectSDK.VerifyPayloadChecksum(obj); err != nil {
+		return fmt.Errorf("%w: payload: %v", ErrCorruptedObject, err)
+	}
+
+	return nil
+}
+
 // GetRange implements common.Storage.
 func (t *FSTree) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
 	res, err := t.Get(common.GetPrm{Address: prm.Address})
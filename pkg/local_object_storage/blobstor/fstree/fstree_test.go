@@ -1,8 +1,19 @@
 package fstree
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
@@ -13,4 +24,275 @@ func TestAddressToString(t *testing.T) {
 	actual, err := addressFromString(s)
 	require.NoError(t, err)
 	require.Equal(t, addr, *actual)
+
+	objStr := addr.Object().EncodeToString()
+	cnrStr := addr.Container().EncodeToString()
+
+	for name, s := range map[string]string{
+		"empty":             "",
+		"no separator":      objStr + cnrStr,
+		"truncated object":  objStr[len(objStr)/2:] + "." + cnrStr,
+		"truncated address": s[:len(s)-len(cnrStr)/2],
+		"missing container": objStr + ".",
+		"extra segment":     s + "." + cnrStr,
+		"extra prefix":      cnrStr + "." + s,
+		"extra bytes":       objStr + "1." + cnrStr,
+		"non-base58":        objStr[:len(objStr)-1] + "0." + cnrStr,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := addressFromString(s)
+			require.ErrorIs(t, err, ErrInvalidAddressPath)
+		})
+	}
+}
+
+func TestAddressToString_Hex(t *testing.T) {
+	addr := oidtest.Address()
+	s := AddressEncodingHex.encode(addr)
+	require.Equal(t, strings.ToLower(s), s)
+
+	actual, err := AddressEncodingHex.decode(s)
+	require.NoError(t, err)
+	require.Equal(t, addr, *actual)
+
+	objStr, cnrStr := strings.Split(s, ".")[0], strings.Split(s, ".")[1]
+
+	for name, s := range map[string]string{
+		"empty":            "",
+		"no separator":     objStr + cnrStr,
+		"truncated object": objStr[1:] + "." + cnrStr,
+		"extra segment":    s + "." + cnrStr,
+		"uppercase":        strings.ToUpper(s),
+		"base58":           stringifyAddress(addr),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := AddressEncodingHex.decode(s)
+			require.ErrorIs(t, err, ErrInvalidAddressPath)
+		})
+	}
+
+	t.Run("tree", func(t *testing.T) {
+		fst := New(WithPath(t.TempDir()), WithDepth(2), WithDirNameLen(2), WithAddressEncoding(AddressEncodingHex))
+		require.NoError(t, fst.Open(false))
+		require.NoError(t, fst.Init())
+
+		_, err := fst.Put(common.PutPrm{Address: addr, RawData: []byte{1}})
+		require.NoError(t, err)
+
+		rel, err := filepath.Rel(fst.RootPath, fst.treePath(addr))
+		require.NoError(t, err)
+		require.Equal(t, strings.ToLower(rel), rel)
+
+		exists, err := fst.Exists(common.ExistsPrm{Address: addr})
+		require.NoError(t, err)
+		require.True(t, exists.Exists)
+
+		var iterated []oid.Address
+		require.NoError(t, fst.IterateAddresses(func(a oid.Address) error {
+			iterated = append(iterated, a)
+			return nil
+		}))
+		require.Equal(t, []oid.Address{addr}, iterated)
+	})
+}
+
+func TestAddressToString_Depth(t *testing.T) {
+	testCases := []struct {
+		depth      uint64
+		dirNameLen int
+	}{
+		{depth: 0, dirNameLen: 1},
+		{depth: 1, dirNameLen: 1},
+		{depth: 4, dirNameLen: 1},
+		{depth: MaxDepth, dirNameLen: 1},
+		{depth: 2, dirNameLen: 2},
+		{depth: 15, dirNameLen: 2},
+		{depth: 3, dirNameLen: 10},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strconv.FormatUint(tc.depth, 10)+"x"+strconv.Itoa(tc.dirNameLen), func(t *testing.T) {
+			root := t.TempDir()
+			fst := New(WithPath(root), WithDepth(tc.depth), WithDirNameLen(tc.dirNameLen))
+			require.NoError(t, fst.Init())
+
+			addr := oidtest.Address()
+			rel, err := filepath.Rel(root, fst.treePath(addr))
+			require.NoError(t, err)
+
+			parts := strings.Split(rel, string(filepath.Separator))
+			require.Len(t, parts, int(tc.depth)+1)
+			for i := range parts {
+				require.NotEmpty(t, parts[i])
+			}
+
+			actual, err := addressFromString(strings.Join(parts, ""))
+			require.NoError(t, err)
+			require.Equal(t, addr, *actual)
+		})
+	}
+
+	t.Run("too deep", func(t *testing.T) {
+		require.Error(t, New(WithPath(t.TempDir()), WithDepth(MaxDepth+1)).Init())
+		require.Error(t, New(WithPath(t.TempDir()), WithDepth(16), WithDirNameLen(2)).Init())
+		require.Error(t, New(WithPath(t.TempDir()), WithDepth(1), WithDirNameLen(0)).Init())
+	})
+}
+
+func TestFSTree_IterateAddresses(t *testing.T) {
+	fst := New(WithPath(t.TempDir()), WithDepth(2), WithDirNameLen(2))
+	require.NoError(t, fst.Open(false))
+	require.NoError(t, fst.Init())
+
+	const count = 10
+
+	expected := make(map[oid.Address]struct{}, count)
+	for i := 0; i < count; i++ {
+		addr := oidtest.Address()
+		expected[addr] = struct{}{}
+
+		_, err := fst.Put(common.PutPrm{Address: addr, RawData: []byte{byte(i)}})
+		require.NoError(t, err)
+
+		p := fst.treePath(addr)
+		require.NoError(t, os.WriteFile(p+".tmp", []byte{byte(i)}, 0600))
+		require.NoError(t, os.Mkdir(filepath.Join(filepath.Dir(p), "dir"), 0700))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(fst.RootPath, "junk"), nil, 0600))
+
+	actual := make(map[oid.Address]struct{}, count)
+	require.NoError(t, fst.IterateAddresses(func(addr oid.Address) error {
+		actual[addr] = struct{}{}
+		return nil
+	}))
+	require.Equal(t, expected, actual)
+
+	t.Run("handler error", func(t *testing.T) {
+		errTest := errors.New("test")
+
+		var n int
+		err := fst.IterateAddresses(func(oid.Address) error {
+			n++
+			return errTest
+		})
+		require.ErrorIs(t, err, errTest)
+		require.Equal(t, 1, n)
+	})
+}
+
+func TestFSTree_MixedCompression(t *testing.T) {
+	dir := t.TempDir()
+
+	newTree := func(compress bool) *FSTree {
+		fst := New(WithPath(dir), WithDepth(2), WithDirNameLen(2))
+		fst.SetCompressor(&compression.Config{Enabled: compress})
+		require.NoError(t, fst.Config.Init())
+		require.NoError(t, fst.Open(false))
+		require.NoError(t, fst.Init())
+		return fst
+	}
+
+	plain, compressed := newTree(false), newTree(true)
+
+	objects := make([]*objectSDK.Object, 2)
+	for i, fst := range []*FSTree{plain, compressed} {
+		objects[i] = blobstortest.NewObject(1024)
+		objects[i].SetPayload(make([]byte, len(objects[i].Payload())))
+
+		data, err := objects[i].Marshal()
+		require.NoError(t, err)
+
+		_, err = fst.Put(common.PutPrm{Address: objectAddress(objects[i]), RawData: data})
+		require.NoError(t, err)
+	}
+
+	for _, fst := range []*FSTree{plain, compressed} {
+		for _, obj := range objects {
+			res, err := fst.Get(common.GetPrm{Address: objectAddress(obj)})
+			require.NoError(t, err)
+			require.Equal(t, obj.Payload(), res.Object.Payload())
+		}
+	}
+}
+
+func TestFSTree_DurabilityMode(t *testing.T) {
+	for _, mode := range []DurabilityMode{DurabilityNone, DurabilityData, DurabilityDataDir} {
+		t.Run(strconv.Itoa(int(mode)), func(t *testing.T) {
+			fst := New(WithPath(t.TempDir()), WithDepth(2), WithDirNameLen(2), WithDurabilityMode(mode))
+			require.NoError(t, fst.Open(false))
+			require.NoError(t, fst.Init())
+
+			obj := blobstortest.NewObject(4096)
+			data, err := obj.Marshal()
+			require.NoError(t, err)
+
+			addr := objectAddress(obj)
+			_, err = fst.Put(common.PutPrm{Address: addr, RawData: data})
+			require.NoError(t, err)
+
+			stored, err := os.ReadFile(fst.treePath(addr))
+			require.NoError(t, err)
+			require.Equal(t, data, stored)
+
+			addr = oidtest.Address()
+			require.NoError(t, fst.PutStream(addr, func(f *os.File) error {
+				_, err := f.Write(data)
+				return err
+			}))
+
+			stored, err = os.ReadFile(fst.treePath(addr))
+			require.NoError(t, err)
+			require.Equal(t, data, stored)
+		})
+	}
+}
+
+func TestFSTree_VerifyObject(t *testing.T) {
+	fst := New(WithPath(t.TempDir()), WithDepth(2), WithDirNameLen(2))
+	require.NoError(t, fst.Open(false))
+	require.NoError(t, fst.Init())
+
+	obj := blobstortest.NewObject(1024)
+	objectSDK.CalculateAndSetPayloadChecksum(obj)
+	require.NoError(t, objectSDK.CalculateAndSetID(obj))
+
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	addr := objectAddress(obj)
+	_, err = fst.Put(common.PutPrm{Address: addr, RawData: data})
+	require.NoError(t, err)
+
+	require.NoError(t, fst.VerifyObject(addr))
+
+	t.Run("missing", func(t *testing.T) {
+		err := fst.VerifyObject(oidtest.Address())
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		corrupted := make([]byte, len(data))
+		copy(corrupted, data)
+		corrupted[len(corrupted)-1]++
+		require.NoError(t, os.WriteFile(fst.treePath(addr), corrupted, 0600))
+
+		require.ErrorIs(t, fst.VerifyObject(addr), ErrCorruptedObject)
+	})
+
+	t.Run("wrong address", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(fst.treePath(addr), data, 0600))
+
+		var other oid.Address
+		other.SetContainer(addr.Container())
+		other.SetObject(oidtest.ID())
+		_, err = fst.Put(common.PutPrm{Address: other, RawData: data})
+		require.NoError(t, err)
+
+		require.ErrorIs(t, fst.VerifyObject(other), ErrCorruptedObject)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(fst.treePath(addr), []byte("garbage"), 0600))
+		require.ErrorIs(t, fst.VerifyObject(addr), ErrCorruptedObject)
+	})
 }
@@ -2,10 +2,14 @@ package fstree
 
 import (
 	"io/fs"
+	"time"
 )
 
 type Option func(*FSTree)
 
+// WithDepth sets the number of nested directory levels. Depth multiplied by
+// the directory name length must not exceed MaxDepth with 1-byte names,
+// otherwise Init fails.
 func WithDepth(d uint64) Option {
 	return func(f *FSTree) {
 		f.Depth = d
@@ -30,8 +34,39 @@ func WithPath(p string) Option {
 	}
 }
 
+// WithNoSync sets DurabilityNone mode if noSync is true and DurabilityData
+// otherwise.
 func WithNoSync(noSync bool) Option {
 	return func(f *FSTree) {
-		f.noSync = noSync
+		if noSync {
+			f.durability = DurabilityNone
+		} else {
+			f.durability = DurabilityData
+		}
+	}
+}
+
+// WithDurabilityMode sets the way written objects are persisted to the disk.
+// Defaults to DurabilityData.
+func WithDurabilityMode(m DurabilityMode) Option {
+	return func(f *FSTree) {
+		f.durability = m
+	}
+}
+
+// WithAddressEncoding sets the encoding of the object addresses in the file
+// paths. Defaults to AddressEncodingBase58. Encoding of the existing tree
+// must not be changed: objects stored with another encoding are not found.
+func WithAddressEncoding(e AddressEncoding) Option {
+	return func(f *FSTree) {
+		f.encoding = e
+	}
+}
+
+// WithDiskUsageCacheInterval sets the time for which CachedDiskUsage result
+// is cached.
+func WithDiskUsageCacheInterval(d time.Duration) Option {
+	return func(f *FSTree) {
+		f.duCache.interval = d
 	}
 }
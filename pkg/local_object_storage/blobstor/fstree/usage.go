@@ -0,0 +1,89 @@
+package fstree
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// diskUsageCache holds the last DiskUsage result.
+type diskUsageCache struct {
+	interval time.Duration
+
+	mtx     sync.Mutex
+	updated time.Time
+	objects uint64
+	bytes   uint64
+}
+
+// DiskUsage walks the file tree rooted at FSTree's root and returns the number
+// of stored objects and their total size in bytes. Files which are not objects
+// are ignored, so the object number matches the one seen by Iterate.
+func (t *FSTree) DiskUsage() (objects uint64, bytes uint64, err error) {
+	root := filepath.Clean(t.RootPath)
+
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		parts := strings.Split(rel, string(filepath.Separator))
+		if uint64(len(parts)) != t.Depth+1 {
+			return nil
+		}
+
+		if _, err := t.encoding.decode(strings.Join(parts, "")); err != nil {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		objects++
+		bytes += uint64(info.Size())
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not walk through %s directory: %w", t.RootPath, err)
+	}
+
+	return objects, bytes, nil
+}
+
+// CachedDiskUsage is like DiskUsage but returns the cached result if it was
+// calculated less than the interval set by WithDiskUsageCacheInterval ago.
+// Without the interval set the result is calculated on each call.
+func (t *FSTree) CachedDiskUsage() (objects uint64, bytes uint64, err error) {
+	c := &t.duCache
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.interval > 0 && !c.updated.IsZero() && time.Since(c.updated) < c.interval {
+		return c.objects, c.bytes, nil
+	}
+
+	objects, bytes, err = t.DiskUsage()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	c.objects, c.bytes, c.updated = objects, bytes, time.Now()
+
+	return objects, bytes, nil
+}
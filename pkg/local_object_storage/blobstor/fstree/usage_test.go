@@ -0,0 +1,65 @@
+package fstree
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSTree_DiskUsage(t *testing.T) {
+	fst := New(WithPath(t.TempDir()), WithDepth(2), WithDirNameLen(2),
+		WithDiskUsageCacheInterval(time.Hour))
+	require.NoError(t, fst.Open(false))
+	require.NoError(t, fst.Init())
+
+	const count = 5
+
+	var size uint64
+	for i := 0; i < count; i++ {
+		addr := oidtest.Address()
+		data := make([]byte, 100*(i+1))
+		size += uint64(len(data))
+
+		_, err := fst.Put(common.PutPrm{Address: addr, RawData: data})
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(fst.treePath(addr)+".tmp", data, 0600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(fst.RootPath, "junk"), []byte("junk"), 0600))
+
+	var iterated uint64
+	require.NoError(t, fst.IterateAddresses(func(oid.Address) error {
+		iterated++
+		return nil
+	}))
+
+	objects, bytes, err := fst.DiskUsage()
+	require.NoError(t, err)
+	require.EqualValues(t, count, objects)
+	require.Equal(t, iterated, objects)
+	require.Equal(t, size, bytes)
+
+	objects, bytes, err = fst.CachedDiskUsage()
+	require.NoError(t, err)
+	require.EqualValues(t, count, objects)
+	require.Equal(t, size, bytes)
+
+	_, err = fst.Put(common.PutPrm{Address: oidtest.Address(), RawData: []byte{1}})
+	require.NoError(t, err)
+
+	objects, bytes, err = fst.CachedDiskUsage()
+	require.NoError(t, err)
+	require.EqualValues(t, count, objects)
+	require.Equal(t, size, bytes)
+
+	objects, bytes, err = fst.DiskUsage()
+	require.NoError(t, err)
+	require.EqualValues(t, count+1, objects)
+	require.Equal(t, size+1, bytes)
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	sc "github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"go.uber.org/zap"
+)
+
+// ContractCall describes a single contract method call of the batch.
+// Supported args types are the same as in Client.Invoke.
+type ContractCall struct {
+	Method string
+	Args   []interface{}
+}
+
+var errEmptyBatch = errors.New("empty batch of contract calls")
+
+// InvokeBatch invokes several methods of the contract in a single transaction.
+// System fee of the transaction is the amount of GAS consumed by the test
+// invocation of the whole script plus fee. If any call faults, the transaction
+// is not sent.
+func (c *Client) InvokeBatch(contract util.Uint160, fee fixedn.Fixed8, calls []ContractCall) error {
+	if len(calls) == 0 {
+		return errEmptyBatch
+	}
+
+	script, err := batchScript(contract, calls)
+	if err != nil {
+		return err
+	}
+
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return ErrConnectionLost
+	}
+
+	tx, err := c.makeRun(script, addFeeCheckerModifier(int64(fee)))
+	if err != nil {
+		return fmt.Errorf("could not invoke batch of %d calls: %w", len(calls), err)
+	}
+
+	txHash, vub, err := c.rpcActor.Send(tx)
+	if err != nil {
+		return fmt.Errorf("could not invoke batch of %d calls: %w", len(calls), err)
+	}
+
+	c.logger.Debug("neo client batch invoke",
+		zap.Int("calls", len(calls)),
+		zap.Uint32("vub", vub),
+		zap.Stringer("tx_hash", txHash.Reverse()))
+
+	return nil
+}
+
+// batchScript builds a script calling all the contract methods one by one.
+func batchScript(contract util.Uint160, calls []ContractCall) ([]byte, error) {
+	w := io.NewBufBinWriter()
+
+	for i := range calls {
+		args := make([]interface{}, len(calls[i].Args))
+
+		for j := range calls[i].Args {
+			p, err := toStackParameter(calls[i].Args[j])
+			if err != nil {
+				return nil, fmt.Errorf("call #%d (%s): %w", i, calls[i].Method, err)
+			}
+
+			args[j] = emitValue(p)
+		}
+
+		emit.AppCall(w.BinWriter, contract, calls[i].Method, callflag.All, args...)
+	}
+
+	if w.Err != nil {
+		return nil, fmt.Errorf("could not build batch script: %w", w.Err)
+	}
+
+	return w.Bytes(), nil
+}
+
+// emitValue converts the parameter to the value supported by emit.Array.
+func emitValue(p sc.Parameter) interface{} {
+	if p.Type != sc.ArrayType {
+		return p.Value
+	}
+
+	arr := p.Value.([]sc.Parameter)
+	res := make([]interface{}, len(arr))
+
+	for i := range arr {
+		res[i] = emitValue(arr[i])
+	}
+
+	return res
+}
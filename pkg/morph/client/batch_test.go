@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchScript(t *testing.T) {
+	contract := util.Uint160{1, 2, 3}
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	script, err := batchScript(contract, []ContractCall{
+		{Method: "put", Args: []interface{}{[]byte{1}, int64(2), "three"}},
+		{Method: "update", Args: []interface{}{keys.PublicKeys{k.PublicKey()}, [][]byte{{4}}}},
+		{Method: "noArgs"},
+	})
+	require.NoError(t, err)
+
+	w := io.NewBufBinWriter()
+	emit.AppCall(w.BinWriter, contract, "put", callflag.All, []byte{1}, int64(2), "three")
+	emit.AppCall(w.BinWriter, contract, "update", callflag.All,
+		[]interface{}{k.PublicKey().Bytes()}, []interface{}{[]byte{4}})
+	emit.AppCall(w.BinWriter, contract, "noArgs", callflag.All)
+	require.NoError(t, w.Err)
+	require.Equal(t, w.Bytes(), script)
+
+	_, err = batchScript(contract, []ContractCall{{Method: "bad", Args: []interface{}{struct{}{}}}})
+	require.Error(t, err)
+}
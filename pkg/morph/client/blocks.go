@@ -0,0 +1,178 @@
+package client
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
+	"go.uber.org/zap"
+)
+
+// triggerBlockReplay makes notification loop send blocks from the next
+// expected height up to the current chain tip if block events resumption
+// is enabled (see SubscribeToBlocksFrom).
+func (c *Client) triggerBlockReplay() {
+	if !c.resumeBlocks.Load() {
+		return
+	}
+
+	select {
+	case c.blockReplay <- struct{}{}:
+	default:
+		// replay is already pending
+	}
+}
+
+// sendResult is a result of sending the notification to the notification
+// channel.
+type sendResult uint8
+
+const (
+	// notificationSent means the notification has been received by the reader.
+	notificationSent sendResult = iota
+	// notificationDropped means the notification has been dropped because of
+	// the reader lag, see WithMaxNotificationLag.
+	notificationDropped
+	// notificationClosed means the Client has been closed before the
+	// notification was received.
+	notificationClosed
+	// notificationIncomplete means the block replay has been interrupted
+	// because some block could not be fetched from the RPC node.
+	notificationIncomplete
+)
+
+// blockGetter is an RPC client that can get blocks by their indices.
+type blockGetter interface {
+	GetBlockByIndex(uint32) (*block.Block, error)
+}
+
+// activeBlockGetter is a blockGetter using the currently active RPC node
+// of the Client.
+type activeBlockGetter struct {
+	c *Client
+}
+
+func (x activeBlockGetter) GetBlockByIndex(h uint32) (*block.Block, error) {
+	x.c.switchLock.RLock()
+	defer x.c.switchLock.RUnlock()
+
+	return x.c.client.GetBlockByIndex(h)
+}
+
+// sendNotification sends n to the notification channel. If n is a block event
+// and the reader does not receive it for the maximum notification lag (see
+// WithMaxNotificationLag), n is dropped. Other notifications are never dropped.
+func (c *Client) sendNotification(n rpcclient.Notification) sendResult {
+	var lagC <-chan time.Time
+
+	if c.cfg.maxNotificationLag > 0 && n.Type == neorpc.BlockEventID {
+		t := time.NewTimer(c.cfg.maxNotificationLag)
+		defer t.Stop()
+
+		lagC = t.C
+	}
+
+	select {
+	case c.notifications <- n:
+		return notificationSent
+	case <-lagC:
+		c.droppedNotifications.Inc()
+
+		c.logger.Error("notification reader is lagging, block notification dropped",
+			zap.Duration("lag", c.cfg.maxNotificationLag),
+			zap.Uint64("dropped", c.droppedNotifications.Load()),
+		)
+
+		return notificationDropped
+	case <-c.closeChan:
+		return notificationClosed
+	case <-c.cfg.ctx.Done():
+		return notificationClosed
+	}
+}
+
+// handleNotification sends n to the notification channel. If block events
+// resumption is enabled, blocks missed before the block event are fetched
+// using cli and sent first, already sent blocks are skipped. If any of the
+// missed blocks can't be fetched or is dropped (see WithMaxNotificationLag),
+// neither it nor the following blocks (including the one from n) are marked
+// as sent, they are sent on the next replay. Returns false if the Client is
+// being closed.
+func (c *Client) handleNotification(cli blockGetter, n rpcclient.Notification) bool {
+	if n.Type != neorpc.BlockEventID || !c.resumeBlocks.Load() {
+		return c.sendNotification(n) != notificationClosed
+	}
+
+	b, ok := n.Value.(*block.Block)
+	if !ok {
+		return c.sendNotification(n) != notificationClosed
+	}
+
+	if b.Index < c.nextBlock.Load() {
+		// already sent
+		return true
+	}
+
+	switch c.replayBlocks(cli, b.Index) {
+	case notificationClosed:
+		return false
+	case notificationDropped, notificationIncomplete:
+		// sending the block now would skip the missed ones
+		return true
+	}
+
+	switch c.sendNotification(n) {
+	case notificationClosed:
+		return false
+	case notificationDropped:
+		return true
+	}
+
+	c.nextBlock.Store(b.Index + 1)
+
+	return true
+}
+
+// replayToTip sends blocks from the next expected height up to the current
+// chain tip. Returns false if the Client is being closed.
+func (c *Client) replayToTip() bool {
+	c.switchLock.RLock()
+	count, err := c.client.GetBlockCount()
+	c.switchLock.RUnlock()
+
+	if err != nil {
+		c.logger.Warn("could not get block count to replay blocks", zap.Error(err))
+		return true
+	}
+
+	return c.replayBlocks(activeBlockGetter{c}, count) != notificationClosed
+}
+
+// replayBlocks fetches blocks from the next expected height up to (but not
+// including) till using cli and sends them. Replay stops at the block that
+// can't be fetched and returns notificationIncomplete, or at the block
+// dropped because of the reader lag and returns notificationDropped. In both
+// cases the block is sent again on the next replay. Returns
+// notificationClosed if the Client is being closed.
+func (c *Client) replayBlocks(cli blockGetter, till uint32) sendResult {
+	for h := c.nextBlock.Load(); h < till; h++ {
+		b, err := cli.GetBlockByIndex(h)
+		if err != nil {
+			c.logger.Warn("could not get block to replay",
+				zap.Uint32("height", h),
+				zap.Error(err),
+			)
+
+			return notificationIncomplete
+		}
+
+		if res := c.sendNotification(rpcclient.Notification{Type: neorpc.BlockEventID, Value: b}); res != notificationSent {
+			return res
+		}
+
+		c.nextBlock.Store(h + 1)
+	}
+
+	return notificationSent
+}
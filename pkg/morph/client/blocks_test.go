@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testBlockGetter struct {
+	// blocks available on the RPC node, others can't be fetched
+	available map[uint32]struct{}
+}
+
+func (x testBlockGetter) GetBlockByIndex(h uint32) (*block.Block, error) {
+	if _, ok := x.available[h]; !ok {
+		return nil, errors.New("block not found")
+	}
+
+	b := new(block.Block)
+	b.Index = h
+
+	return b, nil
+}
+
+func TestClient_HandleNotification(t *testing.T) {
+	c := &Client{
+		logger:        &logger.Logger{Logger: zap.NewNop()},
+		cfg:           cfg{ctx: context.Background()},
+		notifications: make(chan rpcclient.Notification, 10),
+		closeChan:     make(chan struct{}),
+		blockReplay:   make(chan struct{}, 1),
+	}
+
+	blockEvent := func(h uint32) rpcclient.Notification {
+		b := new(block.Block)
+		b.Index = h
+
+		return rpcclient.Notification{Type: neorpc.BlockEventID, Value: b}
+	}
+
+	received := func() []uint32 {
+		var res []uint32
+		for len(c.notifications) > 0 {
+			res = append(res, (<-c.notifications).Value.(*block.Block).Index)
+		}
+		return res
+	}
+
+	// resumption is disabled, all the blocks are passed
+	require.True(t, c.handleNotification(testBlockGetter{}, blockEvent(5)))
+	require.True(t, c.handleNotification(testBlockGetter{}, blockEvent(5)))
+	require.Equal(t, []uint32{5, 5}, received())
+
+	c.triggerBlockReplay()
+	require.Empty(t, c.blockReplay)
+
+	c.nextBlock.Store(10)
+	c.resumeBlocks.Store(true)
+
+	c.triggerBlockReplay()
+	c.triggerBlockReplay()
+	require.Len(t, c.blockReplay, 1)
+
+	// already sent blocks are skipped
+	require.True(t, c.handleNotification(testBlockGetter{}, blockEvent(9)))
+	require.True(t, c.handleNotification(testBlockGetter{}, blockEvent(10)))
+	require.True(t, c.handleNotification(testBlockGetter{}, blockEvent(10)))
+	require.True(t, c.handleNotification(testBlockGetter{}, blockEvent(11)))
+	require.Equal(t, []uint32{10, 11}, received())
+	require.EqualValues(t, 12, c.nextBlock.Load())
+
+	// other notifications are passed
+	require.True(t, c.handleNotification(testBlockGetter{}, rpcclient.Notification{Type: neorpc.NotificationEventID}))
+	require.Len(t, c.notifications, 1)
+	<-c.notifications
+
+	// missed blocks are replayed
+	cli := testBlockGetter{available: map[uint32]struct{}{12: {}, 13: {}}}
+	require.True(t, c.handleNotification(cli, blockEvent(14)))
+	require.Equal(t, []uint32{12, 13, 14}, received())
+	require.EqualValues(t, 15, c.nextBlock.Load())
+
+	// missed block can't be fetched, the event block is not sent until
+	// the missed ones are replayed
+	cli = testBlockGetter{available: map[uint32]struct{}{15: {}}}
+	require.True(t, c.handleNotification(cli, blockEvent(17)))
+	require.Equal(t, []uint32{15}, received())
+	require.EqualValues(t, 16, c.nextBlock.Load())
+
+	require.True(t, c.handleNotification(cli, blockEvent(18)))
+	require.Empty(t, received())
+	require.EqualValues(t, 16, c.nextBlock.Load())
+
+	cli.available[16] = struct{}{}
+	cli.available[17] = struct{}{}
+	require.True(t, c.handleNotification(cli, blockEvent(18)))
+	require.Equal(t, []uint32{16, 17, 18}, received())
+	require.EqualValues(t, 19, c.nextBlock.Load())
+
+	close(c.closeChan)
+	c.notifications = make(chan rpcclient.Notification)
+	require.False(t, c.handleNotification(testBlockGetter{}, blockEvent(19)))
+	require.EqualValues(t, 19, c.nextBlock.Load())
+}
+
+func TestClient_SendNotificationLag(t *testing.T) {
+	c := &Client{
+		logger:        &logger.Logger{Logger: zap.NewNop()},
+		cfg:           cfg{ctx: context.Background(), maxNotificationLag: time.Millisecond},
+		notifications: make(chan rpcclient.Notification),
+		closeChan:     make(chan struct{}),
+	}
+
+	// nobody reads the channel
+	require.Equal(t, notificationDropped, c.sendNotification(rpcclient.Notification{Type: neorpc.BlockEventID}))
+	require.Equal(t, notificationDropped, c.sendNotification(rpcclient.Notification{Type: neorpc.BlockEventID}))
+	require.EqualValues(t, 2, c.DroppedNotifications())
+
+	t.Run("other notifications", func(t *testing.T) {
+		for _, typ := range []neorpc.EventID{neorpc.NotificationEventID, neorpc.NotaryRequestEventID} {
+			done := make(chan struct{})
+			go func() {
+				// longer than the lag
+				time.Sleep(10 * time.Millisecond)
+				<-c.notifications
+				close(done)
+			}()
+
+			require.Equal(t, notificationSent, c.sendNotification(rpcclient.Notification{Type: typ}))
+			<-done
+		}
+
+		require.EqualValues(t, 2, c.DroppedNotifications())
+	})
+
+	t.Run("block resumption", func(t *testing.T) {
+		c.nextBlock.Store(10)
+		c.resumeBlocks.Store(true)
+
+		b := new(block.Block)
+		b.Index = 10
+
+		// dropped block is not marked as sent
+		require.True(t, c.handleNotification(testBlockGetter{}, rpcclient.Notification{Type: neorpc.BlockEventID, Value: b}))
+		require.EqualValues(t, 10, c.nextBlock.Load())
+		require.EqualValues(t, 3, c.DroppedNotifications())
+
+		c.resumeBlocks.Store(false)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		<-c.notifications
+		close(done)
+	}()
+
+	c.cfg.maxNotificationLag = time.Minute
+
+	require.Equal(t, notificationSent, c.sendNotification(rpcclient.Notification{Type: neorpc.BlockEventID}))
+	<-done
+	require.EqualValues(t, 3, c.DroppedNotifications())
+
+	close(c.closeChan)
+	require.Equal(t, notificationClosed, c.sendNotification(rpcclient.Notification{Type: neorpc.BlockEventID}))
+}
@@ -5,22 +5,31 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"math/rand"
 	"sync"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
 	"github.com/nspcc-dev/neo-go/pkg/core/native/noderoles"
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/gas"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/nep17"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/rolemgmt"
 	sc "github.com/nspcc-dev/neo-go/pkg/smartcontract"
-	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
 	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
 	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
@@ -53,8 +62,10 @@ type Client struct {
 	gasToken *nep17.Token        // neo-go GAS token wrapper
 	rolemgmt *rolemgmt.Contract  // neo-go Designation contract wrapper
 
-	acc     *wallet.Account // neo account
-	accAddr util.Uint160    // account's address
+	acc       *wallet.Account // neo account, nil if the key is held by accSigner only
+	accAddr   util.Uint160    // account's address
+	accSigner Signer          // signer of the sent transactions
+	actorAcc  *wallet.Account // account of rpcActor
 
 	signer *transaction.Signer
 
@@ -72,14 +83,28 @@ type Client struct {
 	// channel for ws notifications
 	notifications chan rpcclient.Notification
 
-	// channel for internal stop
+	// closed on Client closing, see Close
 	closeChan chan struct{}
+	closeOnce sync.Once
+	closed    bool
+
+	// indicates that notifications channel has been closed,
+	// accessed by the notification loop only
+	notificationsClosed bool
+
+	// closed when the notification loop is finished
+	done chan struct{}
 
 	// cached subscription information
-	subscribedEvents       map[util.Uint160]string
+	subscribedEvents       map[notificationFilter]string
 	subscribedNotaryEvents map[util.Uint160]string
 	subscribedToNewBlocks  bool
 
+	// block events resumption state, see SubscribeToBlocksFrom
+	resumeBlocks atomic.Bool
+	nextBlock    atomic.Uint32
+	blockReplay  chan struct{}
+
 	// indicates that Client is not able to
 	// establish connection to any of the
 	// provided RPC endpoints
@@ -89,14 +114,38 @@ type Client struct {
 	// goroutine that tries to switch to the higher
 	// priority RPC node
 	switchIsActive atomic.Bool
+
+	// number of successful switches to another RPC node
+	switchCount atomic.Uint64
+
+	// number of notifications dropped because of the reader lag
+	droppedNotifications atomic.Uint64
+
+	// client, readable without switchLock to drop the connection
+	// on the invocation timeout (see dropConnection)
+	conn atomic.Value // *rpcclient.WSClient
+	// indicates that the connection has been dropped because of the
+	// invocation timeout, so the Client must switch to another RPC node
+	connDropped atomic.Bool
 }
 
 type cache struct {
 	m *sync.RWMutex
 
-	nnsHash   *util.Uint160
-	gKey      *keys.PublicKey
-	txHeights *lru.Cache
+	nnsHash *util.Uint160
+	gKey    *keys.PublicKey
+	// txCacheEntry of the persisted transactions, they never change
+	txs *lru.Cache
+
+	committeeKeys keys.PublicKeys
+	committeeExp  time.Time
+
+	contracts map[string]contractCacheEntry
+}
+
+type contractCacheEntry struct {
+	hash util.Uint160
+	exp  time.Time // zero if the entry does not expire
 }
 
 func (c cache) nns() *util.Uint160 {
@@ -113,6 +162,13 @@ func (c *cache) setNNSHash(nnsHash util.Uint160) {
 	c.nnsHash = &nnsHash
 }
 
+func (c *cache) dropNNSHash() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.nnsHash = nil
+}
+
 func (c cache) groupKey() *keys.PublicKey {
 	c.m.RLock()
 	defer c.m.RUnlock()
@@ -127,13 +183,121 @@ func (c *cache) setGroupKey(groupKey *keys.PublicKey) {
 	c.gKey = groupKey
 }
 
+// transactionHeightGetter is an RPC client that
+// can get height of the persisted transaction.
+type transactionHeightGetter interface {
+	GetTransactionHeight(util.Uint256) (uint32, error)
+}
+
+// txCacheEntry describes the cached persisted transaction.
+type txCacheEntry struct {
+	status TxStatus
+	// false if only the height is known
+	statusKnown bool
+}
+
+// cachedTx returns the cached transaction.
+func (c cache) cachedTx(h util.Uint256) (txCacheEntry, bool) {
+	v, ok := c.txs.Get(h)
+	if !ok {
+		return txCacheEntry{}, false
+	}
+	return v.(txCacheEntry), true
+}
+
+// cacheTx stores the transaction in the cache.
+func (c cache) cacheTx(h util.Uint256, e txCacheEntry) {
+	c.txs.Add(h, e)
+}
+
+// transactionHeight returns cached height of the transaction. If the height
+// is not cached, it is requested from the RPC client and stored in the cache.
+// Height is also taken from the cached transaction status (see TxStatuses).
+func (c cache) transactionHeight(cli transactionHeightGetter, h util.Uint256) (uint32, error) {
+	if e, ok := c.cachedTx(h); ok {
+		return e.status.Height, nil
+	}
+	height, err := cli.GetTransactionHeight(h)
+	if err != nil {
+		return 0, err
+	}
+	c.cacheTx(h, txCacheEntry{status: TxStatus{Height: height}})
+	return height, nil
+}
+
+// committeeGetter is an RPC client that can get the committee list.
+type committeeGetter interface {
+	GetCommittee() (keys.PublicKeys, error)
+}
+
+// committee returns cached committee list. If the list is not cached or
+// cached more than ttl ago, it is requested from the RPC client and stored
+// in the cache. Non-positive ttl disables caching.
+func (c *cache) committee(cli committeeGetter, ttl time.Duration) (keys.PublicKeys, error) {
+	if ttl <= 0 {
+		return cli.GetCommittee()
+	}
+
+	c.m.RLock()
+	if c.committeeKeys != nil && time.Now().Before(c.committeeExp) {
+		res := append(keys.PublicKeys(nil), c.committeeKeys...)
+		c.m.RUnlock()
+		return res, nil
+	}
+	c.m.RUnlock()
+
+	res, err := cli.GetCommittee()
+	if err != nil {
+		return nil, err
+	}
+
+	c.m.Lock()
+	c.committeeKeys = append(keys.PublicKeys(nil), res...)
+	c.committeeExp = time.Now().Add(ttl)
+	c.m.Unlock()
+
+	return res, nil
+}
+
+// contractHash returns cached hash of the contract with the given NNS name.
+// If the hash is not cached or cached more than ttl ago, it is resolved and
+// stored in the cache. Non-positive ttl makes the hash cached until the cache
+// is invalidated.
+func (c *cache) contractHash(name string, ttl time.Duration, resolve func() (util.Uint160, error)) (util.Uint160, error) {
+	c.m.RLock()
+	e, ok := c.contracts[name]
+	c.m.RUnlock()
+
+	if ok && (e.exp.IsZero() || time.Now().Before(e.exp)) {
+		return e.hash, nil
+	}
+
+	h, err := resolve()
+	if err != nil {
+		return util.Uint160{}, err
+	}
+
+	e = contractCacheEntry{hash: h}
+	if ttl > 0 {
+		e.exp = time.Now().Add(ttl)
+	}
+
+	c.m.Lock()
+	c.contracts[name] = e
+	c.m.Unlock()
+
+	return h, nil
+}
+
 func (c *cache) invalidate() {
 	c.m.Lock()
 	defer c.m.Unlock()
 
 	c.nnsHash = nil
 	c.gKey = nil
-	c.txHeights.Purge()
+	c.committeeKeys = nil
+	c.contracts = make(map[string]contractCacheEntry)
+	c.txs.Purge()
 }
 
 var (
@@ -144,20 +308,71 @@ var (
 	// ErrConnectionLost is returned when client lost web socket connection
 	// to the RPC node and has not been able to establish a new one since.
 	ErrConnectionLost = errors.New("connection to the RPC node has been lost")
+
+	// ErrInvokeTimeout is returned when the contract invocation has not
+	// finished within the timeout specified by WithInvokeTimeout. If the
+	// timeout occurs while the transaction is being sent, it may still
+	// reach the network, so the invocation retried by the caller may be
+	// executed twice.
+	ErrInvokeTimeout = errors.New("contract invocation timed out")
+
+	// ErrTxExpired is returned by WaitTx when the chain height exceeds the
+	// last block the transaction is valid for and the transaction has not
+	// been persisted.
+	ErrTxExpired = errors.New("transaction has expired")
 )
 
+// ErrInsufficientGas is returned by Invoke (and its variations) if the GAS
+// balance check is enabled (see WithMinGasBalance) and the balance does not
+// cover the transaction fees. Use errors.As with InsufficientGasError to
+// get the details.
+var ErrInsufficientGas = errors.New("insufficient GAS balance")
+
+// InsufficientGasError describes the lack of GAS to send the transaction.
+// It wraps ErrInsufficientGas.
+type InsufficientGasError struct {
+	// Balance is a GAS balance of the Client account.
+	Balance fixedn.Fixed8
+	// Required is a sum of the transaction fees and the minimum balance.
+	Required fixedn.Fixed8
+}
+
+// Shortfall returns the amount of GAS lacking to send the transaction.
+func (e InsufficientGasError) Shortfall() fixedn.Fixed8 {
+	return e.Required - e.Balance
+}
+
+func (e InsufficientGasError) Error() string {
+	return fmt.Sprintf("%s: balance %s, required %s, top up at least %s GAS",
+		ErrInsufficientGas, e.Balance, e.Required, e.Shortfall())
+}
+
+func (e InsufficientGasError) Unwrap() error {
+	return ErrInsufficientGas
+}
+
 // HaltState returned if TestInvoke function processed without panic.
 const HaltState = "HALT"
 
-type notHaltStateError struct {
-	state, exception string
+// FaultError is returned when the contract execution finishes with a state
+// other than HALT. Use errors.As to access it:
+//
+//	var faultErr client.FaultError
+//	if errors.As(err, &faultErr) {
+//		// handle faultErr.Exception
+//	}
+type FaultError struct {
+	// State is a final state of the VM.
+	State string
+	// Exception is a message of the exception thrown during the execution.
+	Exception string
 }
 
-func (e *notHaltStateError) Error() string {
+func (e FaultError) Error() string {
 	return fmt.Sprintf(
 		"chain/client: contract execution finished with state %s; exception: %s",
-		e.state,
-		e.exception,
+		e.State,
+		e.Exception,
 	)
 }
 
@@ -172,6 +387,10 @@ func (e neofsError) Error() string {
 	return fmt.Sprintf("neofs error: %v", e.err)
 }
 
+func (e neofsError) Unwrap() error {
+	return e.err
+}
+
 // wraps NeoFS-specific error into neofsError. Arg must not be nil.
 func wrapNeoFSError(err error) error {
 	return neofsError{err}
@@ -180,16 +399,124 @@ func wrapNeoFSError(err error) error {
 // Invoke invokes contract method by sending transaction into blockchain.
 // Supported args types: int64, string, util.Uint160, []byte and bool.
 func (c *Client) Invoke(contract util.Uint160, fee fixedn.Fixed8, method string, args ...interface{}) error {
+	return c.InvokeContext(context.Background(), contract, fee, method, args...)
+}
+
+// InvokeContext is the same as Invoke but allows to cancel the invocation.
+// Context is checked before the test invocation of the method and before
+// sending the resulting transaction. If the context is done, the returned error
+// wraps ctx.Err().
+//
+// If the invocation timeout (see WithInvokeTimeout) or the context cancellation
+// occurs while the transaction is being sent, the transaction may still reach
+// the network, so retrying the invocation may duplicate it.
+func (c *Client) InvokeContext(ctx context.Context, contract util.Uint160, fee fixedn.Fixed8, method string, args ...interface{}) error {
+	start := time.Now()
+	finished, err := c.withInvokeTimeout(ctx, func(ctx context.Context) error {
+		_, _, err := c.invoke(ctx, contract, fee, method, args...)
+		return err
+	})
+	if !finished {
+		err = fmt.Errorf("could not invoke %s: %w", method, err)
+	}
+
+	c.reportInvoke(method, start, err)
+
+	return err
+}
+
+// InvokeWithResult is the same as Invoke but also returns the hash of the sent
+// transaction and the result of its test run made before sending (including
+// the resulting stack and the emitted notifications). If the test run finishes
+// with a state other than HALT, the result is returned along with FaultError
+// and nothing is sent.
+//
+// If the invocation timeout (see WithInvokeTimeout) occurs while the
+// transaction is being sent, ErrInvokeTimeout is returned but the transaction
+// may still reach the network, so retrying the invocation may duplicate it.
+func (c *Client) InvokeWithResult(contract util.Uint160, fee fixedn.Fixed8, method string, args ...interface{}) (util.Uint256, *result.Invoke, error) {
+	var (
+		start  = time.Now()
+		txHash util.Uint256
+		res    *result.Invoke
+	)
+
+	finished, err := c.withInvokeTimeout(context.Background(), func(ctx context.Context) (err error) {
+		txHash, res, err = c.invoke(ctx, contract, fee, method, args...)
+		return err
+	})
+	if !finished {
+		// invocation may be still in progress
+		err = fmt.Errorf("could not invoke %s: %w", method, err)
+		c.reportInvoke(method, start, err)
+
+		return util.Uint256{}, nil, err
+	}
+
+	c.reportInvoke(method, start, err)
+
+	return txHash, res, err
+}
+
+func (c *Client) invoke(ctx context.Context, contract util.Uint160, fee fixedn.Fixed8, method string, args ...interface{}) (util.Uint256, *result.Invoke, error) {
 	c.switchLock.RLock()
 	defer c.switchLock.RUnlock()
 
 	if c.inactive {
-		return ErrConnectionLost
+		return util.Uint256{}, nil, ErrConnectionLost
 	}
 
-	txHash, vub, err := c.rpcActor.SendTunedCall(contract, method, nil, addFeeCheckerModifier(int64(fee)), args...)
+	if err := ctx.Err(); err != nil {
+		return util.Uint256{}, nil, fmt.Errorf("could not invoke %s: %w", method, err)
+	}
+
+	var (
+		tx         *transaction.Transaction
+		res        *result.Invoke
+		feeChecker = addFeeCheckerModifier(int64(fee))
+	)
+
+	err := c.withRetry(ctx, func() (err error) {
+		start := time.Now()
+		tx, err = c.makeCall(contract, method, func(r *result.Invoke, t *transaction.Transaction) error {
+			res = r
+			return feeChecker(r, t)
+		}, args...)
+		c.observeRTT(start, err)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("could not invoke %s: %w", method, err)
+		return util.Uint256{}, res, fmt.Errorf("could not invoke %s: %w", method, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return util.Uint256{}, res, fmt.Errorf("could not invoke %s: %w", method, err)
+	}
+
+	if c.cfg.checkGasBalance {
+		if err := c.checkGasBalance(tx); err != nil {
+			return util.Uint256{}, res, fmt.Errorf("could not invoke %s: %w", method, err)
+		}
+	}
+
+	var (
+		txHash  util.Uint256
+		vub     uint32
+		attempt int
+	)
+
+	err = c.withRetry(ctx, func() (err error) {
+		attempt++
+		start := time.Now()
+		txHash, vub, err = c.rpcActor.Send(tx)
+		c.observeRTT(start, err)
+		if alreadySent(attempt, err) {
+			txHash, vub, err = tx.Hash(), tx.ValidUntilBlock, nil
+		}
+		return err
+	})
+	if err != nil {
+		return util.Uint256{}, res, fmt.Errorf("could not invoke %s: %w", method, err)
 	}
 
 	c.logger.Debug("neo client invoke",
@@ -197,12 +524,138 @@ func (c *Client) Invoke(contract util.Uint160, fee fixedn.Fixed8, method string,
 		zap.Uint32("vub", vub),
 		zap.Stringer("tx_hash", txHash.Reverse()))
 
-	return nil
+	return txHash, res, nil
+}
+
+// InvokeDryRun test-invokes contract method with the same signers as Invoke
+// and returns the full invocation result including the consumed GAS. Nothing
+// is sent to the network. If the execution finishes with a state other than
+// HALT, the result is returned along with FaultError.
+func (c *Client) InvokeDryRun(contract util.Uint160, method string, args ...interface{}) (*result.Invoke, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	res, err := c.rpcActor.Call(contract, method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not test invoke %s: %w", method, err)
+	}
+
+	if res.State != HaltState {
+		return res, wrapNeoFSError(FaultError{State: res.State, Exception: res.FaultException})
+	}
+
+	return res, nil
+}
+
+// CalculateFee returns system and network fees of the transaction
+// invoking contract method. The transaction is built and signed the same way
+// as in Invoke, but not sent.
+func (c *Client) CalculateFee(contract util.Uint160, method string, args ...interface{}) (sysFee int64, netFee int64, err error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return 0, 0, ErrConnectionLost
+	}
+
+	tx, err := c.makeCall(contract, method, addFeeCheckerModifier(0), args...)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not build %s transaction: %w", method, err)
+	}
+
+	return tx.SystemFee, tx.NetworkFee, nil
 }
 
 // TestInvoke invokes contract method locally in neo-go node. This method should
 // be used to read data from smart-contract.
 func (c *Client) TestInvoke(contract util.Uint160, method string, args ...interface{}) (res []stackitem.Item, err error) {
+	return c.TestInvokeWithSigners(contract, method, nil, args...)
+}
+
+// TestInvokeWithSigners is the same as TestInvoke but allows to specify the
+// signers of the invocation, e.g. for methods checking the witness of the
+// particular account. Nil signers means the signer of the Client.
+func (c *Client) TestInvokeWithSigners(contract util.Uint160, method string, signers []transaction.Signer, args ...interface{}) (res []stackitem.Item, err error) {
+	var (
+		start = time.Now()
+		r     []stackitem.Item
+	)
+
+	finished, err := c.withInvokeTimeout(context.Background(), func(ctx context.Context) (err error) {
+		r, err = c.testInvoke(ctx, contract, method, signers, args...)
+		return err
+	})
+	if !finished {
+		// invocation may be still in progress
+		err = fmt.Errorf("could not test invoke %s: %w", method, err)
+		c.reportInvoke(method, start, err)
+
+		return nil, err
+	}
+
+	c.reportInvoke(method, start, err)
+
+	return r, err
+}
+
+// withInvokeTimeout calls f with the context limited by the invocation timeout
+// of the Client (if any) and returns the error of f. If f has not returned
+// before the timeout or before the given context is done, withInvokeTimeout
+// returns false with ErrInvokeTimeout or ctx.Err() respectively. In this case
+// f may still be running, so the values it sets must not be accessed. On the
+// timeout, the connection to the current RPC node is dropped (see
+// dropConnection) to make f fail.
+func (c *Client) withInvokeTimeout(ctx context.Context, f func(context.Context) error) (bool, error) {
+	if c.cfg.invokeTimeout <= 0 {
+		return true, f(ctx)
+	}
+
+	tCtx, cancel := context.WithTimeout(ctx, c.cfg.invokeTimeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- f(tCtx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return true, err
+	case <-tCtx.Done():
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		// f may hang on the unresponsive RPC node holding switchLock
+		// and blocking the switch and closing of the Client
+		c.dropConnection()
+
+		return false, ErrInvokeTimeout
+	}
+}
+
+// dropConnection closes the connection to the current RPC node, so the calls
+// waiting for it fail and the Client switches to another RPC node.
+func (c *Client) dropConnection() {
+	cli, _ := c.conn.Load().(*rpcclient.WSClient)
+	if cli == nil {
+		return
+	}
+
+	c.logger.Warn("contract invocation timed out, dropping connection to the RPC node")
+
+	c.connDropped.Store(true)
+
+	// closing waits for the connection routines
+	go cli.Close()
+}
+
+func (c *Client) testInvoke(ctx context.Context, contract util.Uint160, method string, signers []transaction.Signer, args ...interface{}) (res []stackitem.Item, err error) {
 	c.switchLock.RLock()
 	defer c.switchLock.RUnlock()
 
@@ -210,20 +663,76 @@ func (c *Client) TestInvoke(contract util.Uint160, method string, args ...interf
 		return nil, ErrConnectionLost
 	}
 
-	val, err := c.rpcActor.Call(contract, method, args...)
+	var val *result.Invoke
+
+	err = c.withRetry(ctx, func() (err error) {
+		start := time.Now()
+		defer func() { c.observeRTT(start, err) }()
+
+		if signers == nil {
+			val, err = c.rpcActor.Call(contract, method, args...)
+		} else {
+			val, err = invoker.New(c.client, signers).Call(contract, method, args...)
+		}
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	if val.State != HaltState {
-		return nil, wrapNeoFSError(&notHaltStateError{state: val.State, exception: val.FaultException})
+		return nil, wrapNeoFSError(FaultError{State: val.State, Exception: val.FaultException})
 	}
 
 	return val.Stack, nil
 }
 
+// SendRawTransaction sends the transaction built and signed elsewhere to the
+// RPC node and returns its hash. The transaction is sent as is. An error is
+// returned if the RPC node reports the hash differing from the transaction
+// one.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) SendRawTransaction(tx *transaction.Transaction) (util.Uint256, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return util.Uint256{}, ErrConnectionLost
+	}
+
+	txHash, err := c.client.SendRawTransaction(tx)
+	if err != nil {
+		return util.Uint256{}, fmt.Errorf("could not send transaction: %w", err)
+	}
+
+	if expected := tx.Hash(); !txHash.Equals(expected) {
+		return util.Uint256{}, fmt.Errorf("RPC node returned wrong transaction hash %s instead of %s",
+			txHash.StringLE(), expected.StringLE())
+	}
+
+	c.logger.Debug("raw transaction sent",
+		zap.Stringer("tx_hash", txHash.Reverse()),
+		zap.Uint32("vub", tx.ValidUntilBlock))
+
+	return txHash, nil
+}
+
 // TransferGas to the receiver from local wallet.
 func (c *Client) TransferGas(receiver util.Uint160, amount fixedn.Fixed8) error {
+	return c.TransferToken(gas.Hash, receiver, int64(amount))
+}
+
+// TransferGasMulti transfers GAS to several receivers from local wallet in
+// a single transaction. Receivers and amounts are matched by index. If any
+// transfer fails, the transaction is not sent.
+func (c *Client) TransferGasMulti(receivers []util.Uint160, amounts []fixedn.Fixed8) error {
+	script, total, err := gasTransfersScript(c.accAddr, receivers, amounts)
+	if err != nil {
+		return err
+	}
+
 	c.switchLock.RLock()
 	defer c.switchLock.RUnlock()
 
@@ -231,12 +740,82 @@ func (c *Client) TransferGas(receiver util.Uint160, amount fixedn.Fixed8) error
 		return ErrConnectionLost
 	}
 
-	txHash, vub, err := c.gasToken.Transfer(c.accAddr, receiver, big.NewInt(int64(amount)), nil)
+	tx, err := c.makeRun(script, addFeeCheckerModifier(0))
 	if err != nil {
 		return err
 	}
 
-	c.logger.Debug("native gas transfer invoke",
+	txHash, vub, err := c.rpcActor.Send(tx)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("native gas multi transfer invoke",
+		zap.Int("receivers", len(receivers)),
+		zap.Stringer("total", total),
+		zap.Stringer("tx_hash", txHash.Reverse()),
+		zap.Uint32("vub", vub))
+
+	return nil
+}
+
+// gasTransfersScript builds a script transferring GAS from the sender to all the
+// receivers. Each transfer result is asserted. Returns the total transferred amount.
+func gasTransfersScript(from util.Uint160, receivers []util.Uint160, amounts []fixedn.Fixed8) ([]byte, fixedn.Fixed8, error) {
+	if len(receivers) == 0 {
+		return nil, 0, errors.New("empty list of receivers")
+	}
+
+	if len(receivers) != len(amounts) {
+		return nil, 0, fmt.Errorf("number of receivers %d differs from number of amounts %d", len(receivers), len(amounts))
+	}
+
+	var total fixedn.Fixed8
+
+	w := io.NewBufBinWriter()
+	for i := range receivers {
+		emit.AppCall(w.BinWriter, gas.Hash, "transfer", callflag.All, from, receivers[i], int64(amounts[i]), nil)
+		emit.Opcodes(w.BinWriter, opcode.ASSERT)
+
+		total += amounts[i]
+	}
+
+	if w.Err != nil {
+		return nil, 0, fmt.Errorf("could not build transfer script: %w", w.Err)
+	}
+
+	return w.Bytes(), total, nil
+}
+
+// TransferToken transfers amount of NEP-17 token to the receiver from local wallet.
+func (c *Client) TransferToken(token util.Uint160, receiver util.Uint160, amount int64) error {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return ErrConnectionLost
+	}
+
+	w := io.NewBufBinWriter()
+	emit.AppCall(w.BinWriter, token, "transfer", callflag.All, c.accAddr, receiver, amount, nil)
+	emit.Opcodes(w.BinWriter, opcode.ASSERT)
+
+	if w.Err != nil {
+		return fmt.Errorf("could not build transfer script: %w", w.Err)
+	}
+
+	tx, err := c.makeRun(w.Bytes(), addFeeCheckerModifier(0))
+	if err != nil {
+		return err
+	}
+
+	txHash, vub, err := c.rpcActor.Send(tx)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("nep17 transfer invoke",
+		zap.String("token", token.StringLE()),
 		zap.String("to", receiver.StringLE()),
 		zap.Stringer("tx_hash", txHash.Reverse()),
 		zap.Uint32("vub", vub))
@@ -286,10 +865,87 @@ func (c *Client) Wait(ctx context.Context, n uint32) error {
 			return nil
 		}
 
-		time.Sleep(c.cfg.waitInterval)
+		time.Sleep(c.waitPause())
+	}
+}
+
+// WaitValidated blocks until the validated chain height (the height of the
+// latest state root validated by the network) advances by n. If the RPC node
+// does not provide validated state roots, the block count is used instead.
+// The height is checked with the wait interval.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints. If ctx is done before the
+// height advances, the returned error wraps ctx.Err().
+func (c *Client) WaitValidated(ctx context.Context, n uint32) error {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return ErrConnectionLost
+	}
+
+	height, err := validatedHeight(c.client)
+	if err != nil {
+		return err
+	}
+
+	for {
+		newHeight, err := validatedHeight(c.client)
+		if err != nil {
+			return err
+		}
+
+		if newHeight >= height+n {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("could not wait for %d validated blocks: %w", n, ctx.Err())
+		case <-time.After(c.waitPause()):
+		}
 	}
 }
 
+// waitPause returns the pause between the polls of the wait methods: the wait
+// interval with the jitter applied, see WithWaitJitter.
+func (c *Client) waitPause() time.Duration {
+	return jitter(c.cfg.waitInterval, c.cfg.waitJitter, rand.Float64)
+}
+
+// jitter changes d by a random part of it in [-fraction, fraction) range.
+// rnd must return a number in [0, 1) range.
+func jitter(d time.Duration, fraction float64, rnd func() float64) time.Duration {
+	if fraction <= 0 {
+		return d
+	}
+
+	return d + time.Duration(float64(d)*fraction*(2*rnd()-1))
+}
+
+// validatedHeightGetter is an RPC client that can get the chain height.
+type validatedHeightGetter interface {
+	GetStateHeight() (*result.StateHeight, error)
+	GetBlockCount() (uint32, error)
+}
+
+// validatedHeight returns the height of the latest validated state root or,
+// if the RPC node has no validated state roots, the block count.
+func validatedHeight(cli validatedHeightGetter) (uint32, error) {
+	sh, err := cli.GetStateHeight()
+	if err == nil && sh.Validated != 0 {
+		return sh.Validated, nil
+	}
+
+	count, err := cli.GetBlockCount()
+	if err != nil {
+		return 0, fmt.Errorf("can't get blockchain height: %w", err)
+	}
+
+	return count, nil
+}
+
 // GasBalance returns GAS amount in the client's wallet.
 func (c *Client) GasBalance() (res int64, err error) {
 	c.switchLock.RLock()
@@ -307,7 +963,84 @@ func (c *Client) GasBalance() (res int64, err error) {
 	return bal.Int64(), nil
 }
 
+// TokenBalance describes amount of the NEP-17 token.
+type TokenBalance struct {
+	// Token is a hash of the token contract.
+	Token util.Uint160
+
+	Symbol   string
+	Decimals int
+
+	// Amount is an amount of the token in its minimal units.
+	Amount *big.Int
+}
+
+// Balances returns amounts of all the NEP-17 tokens in the client's wallet.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) Balances() ([]TokenBalance, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	res, err := c.client.GetNEP17Balances(c.accAddr)
+	if err != nil {
+		return nil, fmt.Errorf("could not get NEP-17 balances: %w", err)
+	}
+
+	return tokenBalances(res)
+}
+
+func tokenBalances(res *result.NEP17Balances) ([]TokenBalance, error) {
+	bs := make([]TokenBalance, len(res.Balances))
+
+	for i, b := range res.Balances {
+		amount, ok := new(big.Int).SetString(b.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s amount: %s", b.Symbol, b.Amount)
+		}
+
+		bs[i] = TokenBalance{
+			Token:    b.Asset,
+			Symbol:   b.Symbol,
+			Decimals: b.Decimals,
+			Amount:   amount,
+		}
+	}
+
+	return bs, nil
+}
+
+// checkGasBalance checks that the GAS balance of the Client account covers
+// the fees of tx and the minimum balance set by WithMinGasBalance.
+//
+// Must be called with switchLock held.
+func (c *Client) checkGasBalance(tx *transaction.Transaction) error {
+	bal, err := c.gasToken.BalanceOf(c.accAddr)
+	if err != nil {
+		return fmt.Errorf("can't get GAS balance: %w", err)
+	}
+
+	return checkGasCoverage(bal.Int64(), tx.SystemFee+tx.NetworkFee+int64(c.cfg.minGasBalance))
+}
+
+func checkGasCoverage(balance, required int64) error {
+	if balance >= required {
+		return nil
+	}
+
+	return InsufficientGasError{
+		Balance:  fixedn.Fixed8(balance),
+		Required: fixedn.Fixed8(required),
+	}
+}
+
 // Committee returns keys of chain committee from neo native contract.
+// The list is cached if WithCommitteeCacheTTL option is provided.
 func (c *Client) Committee() (res keys.PublicKeys, err error) {
 	c.switchLock.RLock()
 	defer c.switchLock.RUnlock()
@@ -316,7 +1049,7 @@ func (c *Client) Committee() (res keys.PublicKeys, err error) {
 		return nil, ErrConnectionLost
 	}
 
-	return c.client.GetCommittee()
+	return c.cache.committee(c.client, c.cfg.committeeCacheTTL)
 }
 
 // TxHalt returns true if transaction has been successfully executed and persisted.
@@ -328,15 +1061,93 @@ func (c *Client) TxHalt(h util.Uint256) (res bool, err error) {
 		return false, ErrConnectionLost
 	}
 
-	trig := trigger.Application
-	aer, err := c.client.GetApplicationLog(h, &trig)
+	aer, err := applicationLog(c.client, h)
 	if err != nil {
 		return false, err
 	}
-	return len(aer.Executions) > 0 && aer.Executions[0].VMState.HasFlag(vmstate.Halt), nil
+	return aer.VMState.HasFlag(vmstate.Halt), nil
+}
+
+// WaitTx blocks until the transaction is persisted and returns true if it
+// has been successfully executed. Transaction state is polled with the wait
+// interval of the Client while the RPC node reports it as unknown, any other
+// error is returned immediately. vub is the last block the transaction is
+// valid for (see transaction.Transaction.ValidUntilBlock), zero disables the
+// expiration check.
+//
+// Returns ErrTxExpired if the transaction has not been persisted up to vub
+// block, ErrConnectionLost if the Client switches to inactive mode and
+// ctx.Err() if the context is done before the transaction is persisted.
+func (c *Client) WaitTx(ctx context.Context, h util.Uint256, vub uint32) (bool, error) {
+	for {
+		halt, persisted, err := c.txState(h, vub)
+		if err != nil {
+			return false, err
+		}
+
+		if persisted {
+			return halt, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(c.waitPause()):
+		}
+	}
+}
+
+func (c *Client) txState(h util.Uint256, vub uint32) (halt bool, persisted bool, err error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return false, false, ErrConnectionLost
+	}
+
+	return txState(c.client, h, vub)
 }
 
-// TxHeight returns true if transaction has been successfully executed and persisted.
+// txStateGetter is an RPC client that can get the state of the
+// transaction and the chain height.
+type txStateGetter interface {
+	applicationLogGetter
+	GetBlockCount() (uint32, error)
+}
+
+// txState returns the state of the transaction. persisted is false if the
+// transaction is unknown to the RPC node yet. Returns ErrTxExpired if the
+// transaction is unknown while the block vub has already been persisted.
+func txState(cli txStateGetter, h util.Uint256, vub uint32) (halt bool, persisted bool, err error) {
+	var count uint32
+
+	if vub != 0 {
+		// the height is requested first: if vub block is persisted before
+		// the transaction is looked for, the transaction can't appear anymore
+		count, err = cli.GetBlockCount()
+		if err != nil {
+			return false, false, fmt.Errorf("can't get chain height: %w", err)
+		}
+	}
+
+	aer, err := applicationLog(cli, h)
+	if err == nil {
+		return aer.VMState.HasFlag(vmstate.Halt), true, nil
+	}
+
+	if !errors.Is(err, neorpc.ErrUnknownScriptContainer) {
+		return false, false, err
+	}
+
+	if vub != 0 && count > vub {
+		return false, false, ErrTxExpired
+	}
+
+	return false, false, nil
+}
+
+// TxHeight returns height of the block the transaction has been persisted in.
+// Heights of the persisted transactions are cached.
 func (c *Client) TxHeight(h util.Uint256) (res uint32, err error) {
 	c.switchLock.RLock()
 	defer c.switchLock.RUnlock()
@@ -345,7 +1156,7 @@ func (c *Client) TxHeight(h util.Uint256) (res uint32, err error) {
 		return 0, ErrConnectionLost
 	}
 
-	return c.client.GetTransactionHeight(h)
+	return c.getTransactionHeight(h)
 }
 
 // NeoFSAlphabetList returns keys that stored in NeoFS Alphabet role. Main chain
@@ -359,7 +1170,7 @@ func (c *Client) NeoFSAlphabetList() (res keys.PublicKeys, err error) {
 		return nil, ErrConnectionLost
 	}
 
-	list, err := c.roleList(noderoles.NeoFSAlphabet)
+	list, err := c.designatedByRole(noderoles.NeoFSAlphabet, 0)
 	if err != nil {
 		return nil, fmt.Errorf("can't get alphabet nodes role list: %w", err)
 	}
@@ -368,14 +1179,33 @@ func (c *Client) NeoFSAlphabetList() (res keys.PublicKeys, err error) {
 }
 
 // GetDesignateHash returns hash of the native `RoleManagement` contract.
+// Native contract hashes are the same in all networks, so no RPC request
+// is made and the Client may be inactive.
 func (c *Client) GetDesignateHash() util.Uint160 {
 	return rolemgmt.Hash
 }
 
-func (c *Client) roleList(r noderoles.Role) (keys.PublicKeys, error) {
-	height, err := c.rpcActor.GetBlockCount()
-	if err != nil {
-		return nil, fmt.Errorf("can't get chain height: %w", err)
+// DesignatedByRole returns keys of the nodes designated to the role at the
+// specified chain height. Zero height means the current one.
+func (c *Client) DesignatedByRole(r noderoles.Role, height uint32) (keys.PublicKeys, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	return c.designatedByRole(r, height)
+}
+
+func (c *Client) designatedByRole(r noderoles.Role, height uint32) (keys.PublicKeys, error) {
+	if height == 0 {
+		var err error
+
+		height, err = c.rpcActor.GetBlockCount()
+		if err != nil {
+			return nil, fmt.Errorf("can't get chain height: %w", err)
+		}
 	}
 
 	return c.rolemgmt.GetDesignatedByRole(r, height)
@@ -401,24 +1231,40 @@ func toStackParameter(value interface{}) (sc.Parameter, error) {
 	case uint64:
 		result.Type = sc.IntegerType
 		result.Value = new(big.Int).SetUint64(v)
+	case int32:
+		result.Type = sc.IntegerType
+		result.Value = big.NewInt(int64(v))
+	case uint32:
+		result.Type = sc.IntegerType
+		result.Value = new(big.Int).SetUint64(uint64(v))
+	case int16:
+		result.Type = sc.IntegerType
+		result.Value = big.NewInt(int64(v))
+	case uint16:
+		result.Type = sc.IntegerType
+		result.Value = new(big.Int).SetUint64(uint64(v))
+	case int8:
+		result.Type = sc.IntegerType
+		result.Value = big.NewInt(int64(v))
+	case uint8:
+		result.Type = sc.IntegerType
+		result.Value = new(big.Int).SetUint64(uint64(v))
 	case [][]byte:
-		arr := make([]sc.Parameter, 0, len(v))
-		for i := range v {
-			elem, err := toStackParameter(v[i])
-			if err != nil {
-				return result, err
-			}
-
-			arr = append(arr, elem)
-		}
-
-		result.Type = sc.ArrayType
-		result.Value = arr
+		return toArrayParameter(len(v), func(i int) interface{} { return v[i] })
+	case []string:
+		return toArrayParameter(len(v), func(i int) interface{} { return v[i] })
+	case [][]string:
+		return toArrayParameter(len(v), func(i int) interface{} { return v[i] })
+	case []interface{}:
+		return toArrayParameter(len(v), func(i int) interface{} { return v[i] })
 	case string:
 		result.Type = sc.StringType
 	case util.Uint160:
 		result.Type = sc.ByteArrayType
 		result.Value = v.BytesBE()
+	case util.Uint256:
+		result.Type = sc.ByteArrayType
+		result.Value = v.BytesBE()
 	case noderoles.Role:
 		result.Type = sc.IntegerType
 		result.Value = big.NewInt(int64(v))
@@ -439,6 +1285,32 @@ func toStackParameter(value interface{}) (sc.Parameter, error) {
 	return result, nil
 }
 
+// resolves array sc.Parameter with ln elements returned by elem.
+//
+// Wraps any error to neofsError.
+func toArrayParameter(ln int, elem func(int) interface{}) (sc.Parameter, error) {
+	arr := make([]sc.Parameter, 0, ln)
+
+	for i := 0; i < ln; i++ {
+		p, err := toStackParameter(elem(i))
+		if err != nil {
+			var nErr neofsError
+			if errors.As(err, &nErr) {
+				err = nErr.err
+			}
+
+			return sc.Parameter{}, wrapNeoFSError(fmt.Errorf("chain/client: invalid array element #%d: %w", i, err))
+		}
+
+		arr = append(arr, p)
+	}
+
+	return sc.Parameter{
+		Type:  sc.ArrayType,
+		Value: arr,
+	}, nil
+}
+
 // MagicNumber returns the magic number of the network
 // to which the underlying RPC node client is connected.
 func (c *Client) MagicNumber() (uint64, error) {
@@ -465,6 +1337,57 @@ func (c *Client) BlockCount() (res uint32, err error) {
 	return c.rpcActor.GetBlockCount()
 }
 
+// GetBlockHeader returns header of the block with the given hash.
+func (c *Client) GetBlockHeader(h util.Uint256) (*block.Header, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	return c.client.GetBlockHeader(h)
+}
+
+// GetBlockHeaderByIndex returns header of the block with the given index.
+func (c *Client) GetBlockHeaderByIndex(index uint32) (*block.Header, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	h, err := c.client.GetBlockHash(index)
+	if err != nil {
+		return nil, fmt.Errorf("can't get block hash: %w", err)
+	}
+
+	return c.client.GetBlockHeader(h)
+}
+
+// GetContractState returns the state of the deployed contract with the given
+// hash including its NEF and manifest. It allows checking the contract methods
+// before invoking them.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) GetContractState(hash util.Uint160) (*state.Contract, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	cs, err := c.client.GetContractStateByHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("could not get contract %s state: %w", hash.StringLE(), err)
+	}
+
+	return cs, nil
+}
+
 // MsPerBlock returns MillisecondsPerBlock network parameter.
 func (c *Client) MsPerBlock() (res int64, err error) {
 	c.switchLock.RLock()
@@ -499,11 +1422,22 @@ func (c *Client) IsValidScript(script []byte, signers []transaction.Signer) (res
 // NotificationChannel returns channel than receives subscribed
 // notification from the connected RPC node.
 // Channel is closed when connection to the RPC node has been
-// lost without the possibility of recovery.
+// lost without the possibility of recovery. If the Client
+// reconnects (see WithReconnectInterval), the new channel
+// is returned.
 func (c *Client) NotificationChannel() <-chan rpcclient.Notification {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
 	return c.notifications
 }
 
+// DroppedNotifications returns number of the block notifications dropped
+// because of the reader lag, see WithMaxNotificationLag.
+func (c *Client) DroppedNotifications() uint64 {
+	return c.droppedNotifications.Load()
+}
+
 // inactiveMode switches Client to an inactive mode:
 // - notification channel is closed;
 // - all the new RPC request would return ErrConnectionLost;
@@ -512,7 +1446,7 @@ func (c *Client) inactiveMode() {
 	c.switchLock.Lock()
 	defer c.switchLock.Unlock()
 
-	close(c.notifications)
+	c.closeNotifications()
 	c.inactive = true
 
 	if c.cfg.inactiveModeCb != nil {
@@ -520,7 +1454,12 @@ func (c *Client) inactiveMode() {
 	}
 }
 
-func (c *Client) setActor(act *actor.Actor) {
+// setClient sets the WS client of the current RPC node and the actor using it.
+//
+// Must be called with switchLock held.
+func (c *Client) setClient(cli *rpcclient.WSClient, act *actor.Actor) {
+	c.client = cli
+	c.conn.Store(cli)
 	c.rpcActor = act
 	c.gasToken = nep17.New(act, gas.Hash)
 	c.rolemgmt = rolemgmt.New(act)
@@ -1,11 +1,47 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"math/big"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/gas"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/rolemgmt"
 	sc "github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/manifest"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/nef"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
 )
 
 func TestToStackParameter(t *testing.T) {
@@ -55,3 +91,1119 @@ func TestToStackParameter(t *testing.T) {
 		})
 	}
 }
+
+func TestToStackParameter_Hashes(t *testing.T) {
+	h160 := util.Uint160{1, 2, 3}
+	h256 := util.Uint256{1, 2, 3}
+
+	res, err := toStackParameter(h160)
+	require.NoError(t, err)
+	require.Equal(t, sc.Parameter{Type: sc.ByteArrayType, Value: h160.BytesBE()}, res)
+
+	res, err = toStackParameter(h256)
+	require.NoError(t, err)
+	require.Equal(t, sc.Parameter{Type: sc.ByteArrayType, Value: h256.BytesBE()}, res)
+}
+
+func TestToStackParameter_Arrays(t *testing.T) {
+	res, err := toStackParameter([]interface{}{
+		[]string{"key", "value"},
+		[][]string{{"a"}, {"b", "c"}},
+		int64(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sc.Parameter{
+		Type: sc.ArrayType,
+		Value: []sc.Parameter{
+			{Type: sc.ArrayType, Value: []sc.Parameter{
+				{Type: sc.StringType, Value: "key"},
+				{Type: sc.StringType, Value: "value"},
+			}},
+			{Type: sc.ArrayType, Value: []sc.Parameter{
+				{Type: sc.ArrayType, Value: []sc.Parameter{
+					{Type: sc.StringType, Value: "a"},
+				}},
+				{Type: sc.ArrayType, Value: []sc.Parameter{
+					{Type: sc.StringType, Value: "b"},
+					{Type: sc.StringType, Value: "c"},
+				}},
+			}},
+			{Type: sc.IntegerType, Value: big.NewInt(1)},
+		},
+	}, res)
+
+	_, err = toStackParameter([]interface{}{"ok", []interface{}{struct{}{}}})
+	require.ErrorContains(t, err, "invalid array element #1")
+	require.ErrorContains(t, err, "invalid array element #0")
+}
+
+func TestToStackParameter_SmallIntegers(t *testing.T) {
+	for _, item := range []struct {
+		value  interface{}
+		expVal int64
+	}{
+		{value: int32(math.MinInt32), expVal: math.MinInt32},
+		{value: int32(math.MaxInt32), expVal: math.MaxInt32},
+		{value: uint32(0), expVal: 0},
+		{value: uint32(math.MaxUint32), expVal: math.MaxUint32},
+		{value: int16(math.MinInt16), expVal: math.MinInt16},
+		{value: int16(math.MaxInt16), expVal: math.MaxInt16},
+		{value: uint16(0), expVal: 0},
+		{value: uint16(math.MaxUint16), expVal: math.MaxUint16},
+		{value: int8(math.MinInt8), expVal: math.MinInt8},
+		{value: int8(math.MaxInt8), expVal: math.MaxInt8},
+		{value: uint8(0), expVal: 0},
+		{value: uint8(math.MaxUint8), expVal: math.MaxUint8},
+	} {
+		res, err := toStackParameter(item.value)
+		require.NoError(t, err, "%T", item.value)
+		require.Equal(t, sc.IntegerType, res.Type, "%T", item.value)
+		require.Equal(t, big.NewInt(item.expVal), res.Value, "%T(%v)", item.value, item.value)
+	}
+}
+
+func TestClient_InvokeContext(t *testing.T) {
+	c := &Client{switchLock: new(sync.RWMutex)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.InvokeContext(ctx, util.Uint160{}, 0, "method")
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, ErrConnectionLost)
+}
+
+func TestCheckGasCoverage(t *testing.T) {
+	require.NoError(t, checkGasCoverage(100, 100))
+	require.NoError(t, checkGasCoverage(101, 100))
+
+	err := checkGasCoverage(40, 100)
+	require.ErrorIs(t, err, ErrInsufficientGas)
+
+	var gasErr InsufficientGasError
+	require.ErrorAs(t, err, &gasErr)
+	require.EqualValues(t, 40, gasErr.Balance)
+	require.EqualValues(t, 100, gasErr.Required)
+	require.EqualValues(t, 60, gasErr.Shortfall())
+	require.Contains(t, err.Error(), gasErr.Shortfall().String())
+}
+
+func TestCache_NNSHash(t *testing.T) {
+	c := newClientCache()
+	require.Nil(t, c.nns())
+
+	h := util.Uint160{1, 2, 3}
+	c.setNNSHash(h)
+	require.Equal(t, &h, c.nns())
+
+	c.dropNNSHash()
+	require.Nil(t, c.nns())
+
+	c.setNNSHash(h)
+	c.invalidate()
+	require.Nil(t, c.nns())
+}
+
+func TestCache_ContractHash(t *testing.T) {
+	c := newClientCache()
+
+	var calls int
+	h := util.Uint160{1, 2, 3}
+	resolve := func() (util.Uint160, error) {
+		calls++
+		return h, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		res, err := c.contractHash("container.neofs", 0, resolve)
+		require.NoError(t, err)
+		require.Equal(t, h, res)
+	}
+	require.Equal(t, 1, calls)
+
+	c.invalidate()
+	_, err := c.contractHash("container.neofs", 0, resolve)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	_, err = c.contractHash("netmap.neofs", time.Nanosecond, resolve)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = c.contractHash("netmap.neofs", time.Nanosecond, resolve)
+	require.NoError(t, err)
+	require.Equal(t, 4, calls)
+
+	errResolve := fmt.Errorf("%w: any", ErrDomainNotFound)
+	_, err = c.contractHash("unknown.neofs", 0, func() (util.Uint160, error) {
+		calls++
+		return util.Uint160{}, errResolve
+	})
+	require.ErrorIs(t, err, ErrDomainNotFound)
+	_, err = c.contractHash("unknown.neofs", 0, resolve)
+	require.NoError(t, err)
+	require.Equal(t, 6, calls)
+}
+
+func TestClient_ResolveNNSHash(t *testing.T) {
+	c := &Client{switchLock: new(sync.RWMutex), inactive: true}
+
+	_, err := c.ResolveNNSHash()
+	require.ErrorIs(t, err, ErrConnectionLost)
+
+	_, err = c.RefreshNNSHash()
+	require.ErrorIs(t, err, ErrConnectionLost)
+
+	_, err = c.ResolveContract(NNSContainerContractName)
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+type testValidatedHeightGetter struct {
+	stateHeight *result.StateHeight
+	stateErr    error
+	count       uint32
+	countErr    error
+}
+
+func (x testValidatedHeightGetter) GetStateHeight() (*result.StateHeight, error) {
+	return x.stateHeight, x.stateErr
+}
+
+func (x testValidatedHeightGetter) GetBlockCount() (uint32, error) {
+	return x.count, x.countErr
+}
+
+func TestValidatedHeight(t *testing.T) {
+	h, err := validatedHeight(testValidatedHeightGetter{
+		stateHeight: &result.StateHeight{Local: 20, Validated: 15},
+		count:       21,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 15, h)
+
+	h, err = validatedHeight(testValidatedHeightGetter{
+		stateHeight: &result.StateHeight{Local: 20},
+		count:       21,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 21, h)
+
+	h, err = validatedHeight(testValidatedHeightGetter{
+		stateErr: errors.New("method not found"),
+		count:    21,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 21, h)
+
+	errCount := errors.New("any error")
+	_, err = validatedHeight(testValidatedHeightGetter{
+		stateErr: errors.New("method not found"),
+		countErr: errCount,
+	})
+	require.ErrorIs(t, err, errCount)
+}
+
+func TestClient_WaitValidated(t *testing.T) {
+	var (
+		validated   = atomic.NewUint32(10)
+		blockCount  = atomic.NewUint32(20)
+		stateHeight = atomic.NewBool(true)
+		advance     = atomic.NewBool(true)
+	)
+
+	c := newTestRPCClient(t, func(method string, _ []json.RawMessage) (interface{}, error) {
+		switch method {
+		case "getstateheight":
+			if !stateHeight.Load() {
+				return nil, neorpc.NewRPCError("state service is disabled", "")
+			}
+
+			if advance.Load() {
+				return result.StateHeight{Local: 100, Validated: validated.Inc() - 1}, nil
+			}
+			return result.StateHeight{Local: 100, Validated: validated.Load()}, nil
+		case "getblockcount":
+			if advance.Load() {
+				return blockCount.Inc() - 1, nil
+			}
+			return blockCount.Load(), nil
+		default:
+			return nil, fmt.Errorf("unexpected method %s", method)
+		}
+	})
+	c.cfg.waitInterval = time.Millisecond
+
+	// validated height is used, it is requested at start and then until
+	// it advances by 3
+	require.NoError(t, c.WaitValidated(context.Background(), 3))
+	require.EqualValues(t, 10+3+1, validated.Load())
+	require.EqualValues(t, 20, blockCount.Load())
+
+	// block count is used if the RPC node has no state roots
+	stateHeight.Store(false)
+
+	require.NoError(t, c.WaitValidated(context.Background(), 3))
+	require.EqualValues(t, 20+3+1, blockCount.Load())
+
+	// height doesn't advance
+	advance.Store(false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, c.WaitValidated(ctx, 1), context.DeadlineExceeded)
+
+	c.inactive = true
+	require.ErrorIs(t, c.WaitValidated(context.Background(), 1), ErrConnectionLost)
+}
+
+func TestJitter(t *testing.T) {
+	const d = time.Second
+
+	require.Equal(t, d, jitter(d, 0, rand.Float64))
+	require.Equal(t, d/2, jitter(d, 0.5, func() float64 { return 0 }))
+	require.Equal(t, d, jitter(d, 0.5, func() float64 { return 0.5 }))
+
+	const fraction = 0.2
+	lo, hi := d-time.Duration(fraction*float64(d)), d+time.Duration(fraction*float64(d))
+
+	for i := 0; i < 1000; i++ {
+		res := jitter(d, fraction, rand.Float64)
+		require.GreaterOrEqual(t, res, lo)
+		require.Less(t, res, hi)
+	}
+}
+
+func TestClient_SendRawTransaction(t *testing.T) {
+	var (
+		tx       = transaction.New([]byte{byte(opcode.RET)}, 0)
+		respHash atomic.Value
+		respErr  atomic.Value
+	)
+
+	tx.Signers = []transaction.Signer{{Account: util.Uint160{1}}}
+	tx.Scripts = []transaction.Witness{{}}
+
+	c := newTestRPCClient(t, func(method string, params []json.RawMessage) (interface{}, error) {
+		if method != "sendrawtransaction" {
+			return nil, fmt.Errorf("unexpected method %s", method)
+		}
+
+		var b []byte
+		require.NoError(t, json.Unmarshal(params[0], &b))
+		require.Equal(t, tx.Bytes(), b)
+
+		if err, ok := respErr.Load().(error); ok && err != nil {
+			return nil, err
+		}
+
+		return result.RelayResult{Hash: respHash.Load().(util.Uint256)}, nil
+	})
+
+	respHash.Store(tx.Hash())
+
+	txHash, err := c.SendRawTransaction(tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), txHash)
+
+	// RPC node returns hash of another transaction
+	respHash.Store(util.Uint256{1, 2, 3})
+
+	txHash, err = c.SendRawTransaction(tx)
+	require.ErrorContains(t, err, "wrong transaction hash")
+	require.Zero(t, txHash)
+
+	respErr.Store(neorpc.ErrAlreadyExists)
+
+	txHash, err = c.SendRawTransaction(tx)
+	require.ErrorIs(t, err, neorpc.ErrAlreadyExists)
+	require.Zero(t, txHash)
+
+	c.inactive = true
+
+	_, err = c.SendRawTransaction(tx)
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+func TestClient_SubscribeForNotifications(t *testing.T) {
+	type subscription struct {
+		Contract util.Uint160 `json:"contract"`
+		Name     *string      `json:"name"`
+	}
+
+	var (
+		contract = util.Uint160{1}
+		other    = util.Uint160{2}
+
+		mtx          sync.Mutex
+		lastID       int
+		subscribed   = make(map[string]subscription)
+		unsubscribed []string
+	)
+
+	c := newTestRPCClient(t, func(method string, params []json.RawMessage) (interface{}, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		switch method {
+		case "subscribe":
+			var s subscription
+			require.NoError(t, json.Unmarshal(params[1], &s))
+
+			lastID++
+			id := strconv.Itoa(lastID)
+			subscribed[id] = s
+
+			return id, nil
+		case "unsubscribe":
+			var id string
+			require.NoError(t, json.Unmarshal(params[0], &id))
+
+			unsubscribed = append(unsubscribed, id)
+
+			return true, nil
+		default:
+			return nil, fmt.Errorf("unexpected method %s", method)
+		}
+	})
+	c.subscribedEvents = make(map[notificationFilter]string)
+
+	name := func(s string) *string { return &s }
+
+	require.NoError(t, c.SubscribeForNotifications(contract, "Put"))
+	require.NoError(t, c.SubscribeForNotifications(contract, "Put"))
+	require.NoError(t, c.SubscribeForNotifications(contract, "Delete"))
+	require.NoError(t, c.SubscribeForNotifications(other, "Put"))
+	require.Equal(t, map[string]subscription{
+		"1": {Contract: contract, Name: name("Put")},
+		"2": {Contract: contract, Name: name("Delete")},
+		"3": {Contract: other, Name: name("Put")},
+	}, subscribed)
+	require.Empty(t, unsubscribed)
+
+	// subscription for all the events of the contract replaces the name filters
+	require.NoError(t, c.SubscribeForExecutionNotifications(contract))
+	require.Equal(t, subscription{Contract: contract}, subscribed["4"])
+	require.ElementsMatch(t, []string{"1", "2"}, unsubscribed)
+	require.Equal(t, map[notificationFilter]string{
+		{contract: contract}:           "4",
+		{contract: other, name: "Put"}: "3",
+	}, c.subscribedEvents)
+
+	// already covered by the subscription for all the events
+	require.NoError(t, c.SubscribeForNotifications(contract, "Put"))
+	require.Len(t, subscribed, 4)
+
+	c.inactive = true
+
+	require.ErrorIs(t, c.SubscribeForNotifications(util.Uint160{1}, "Put"), ErrConnectionLost)
+}
+
+func TestNotificationFilter_Params(t *testing.T) {
+	contract := util.Uint160{1, 2, 3}
+
+	c, name := notificationFilter{contract: contract}.params()
+	require.Equal(t, contract, *c)
+	require.Nil(t, name)
+
+	c, name = notificationFilter{contract: contract, name: "Put"}.params()
+	require.Equal(t, contract, *c)
+	require.NotNil(t, name)
+	require.Equal(t, "Put", *name)
+}
+
+func TestClient_WithInvokeTimeout(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		c := &Client{}
+		testErr := errors.New("test error")
+
+		finished, err := c.withInvokeTimeout(context.Background(), func(ctx context.Context) error {
+			_, ok := ctx.Deadline()
+			require.False(t, ok)
+			return testErr
+		})
+		require.True(t, finished)
+		require.ErrorIs(t, err, testErr)
+	})
+
+	c := &Client{cfg: cfg{invokeTimeout: 10 * time.Millisecond}}
+
+	t.Run("in time", func(t *testing.T) {
+		finished, err := c.withInvokeTimeout(context.Background(), func(ctx context.Context) error {
+			_, ok := ctx.Deadline()
+			require.True(t, ok)
+			return nil
+		})
+		require.True(t, finished)
+		require.NoError(t, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		finished, err := c.withInvokeTimeout(context.Background(), func(context.Context) error {
+			<-release
+			return nil
+		})
+		require.False(t, finished)
+		require.ErrorIs(t, err, ErrInvokeTimeout)
+		require.False(t, errors.Is(err, ErrConnectionLost))
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		finished, err := c.withInvokeTimeout(ctx, func(context.Context) error {
+			<-release
+			return nil
+		})
+		require.False(t, finished)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestClient_GetDesignateHash(t *testing.T) {
+	// no RPC client, so any request would panic
+	c := &Client{switchLock: new(sync.RWMutex), inactive: true}
+
+	require.Equal(t, rolemgmt.Hash, c.GetDesignateHash())
+	require.Equal(t, rolemgmt.Hash, c.GetDesignateHash())
+}
+
+func TestClient_GetContractState(t *testing.T) {
+	script := []byte{byte(opcode.PUSH1), byte(opcode.RET)}
+
+	nefFile, err := nef.NewFile(script)
+	require.NoError(t, err)
+
+	m := manifest.NewManifest("Test")
+	m.ABI.Methods = []manifest.Method{{
+		Name:       "put",
+		Offset:     0,
+		Parameters: []manifest.Parameter{manifest.NewParameter("key", sc.ByteArrayType)},
+		ReturnType: sc.IntegerType,
+		Safe:       true,
+	}}
+
+	cs := &state.Contract{
+		ContractBase: state.ContractBase{
+			ID:       42,
+			Hash:     state.CreateContractHash(util.Uint160{1}, nefFile.Checksum, m.Name),
+			NEF:      *nefFile,
+			Manifest: *m,
+		},
+		UpdateCounter: 1,
+	}
+
+	c := newTestRPCClient(t, func(method string, params []json.RawMessage) (interface{}, error) {
+		if method != "getcontractstate" {
+			return nil, fmt.Errorf("unexpected method %s", method)
+		}
+
+		var h string
+		require.NoError(t, json.Unmarshal(params[0], &h))
+
+		if h != cs.Hash.StringLE() {
+			return nil, neorpc.NewRPCError("Unknown contract", "")
+		}
+
+		return cs, nil
+	})
+
+	res, err := c.GetContractState(cs.Hash)
+	require.NoError(t, err)
+	require.Equal(t, cs.ID, res.ID)
+	require.Equal(t, cs.Hash, res.Hash)
+	require.Equal(t, cs.UpdateCounter, res.UpdateCounter)
+	require.Equal(t, script, res.NEF.Script)
+	require.Equal(t, nefFile.Checksum, res.NEF.Checksum)
+	require.Equal(t, "Test", res.Manifest.Name)
+
+	md := res.Manifest.ABI.GetMethod("put", 1)
+	require.NotNil(t, md)
+	require.Equal(t, sc.IntegerType, md.ReturnType)
+	require.True(t, md.Safe)
+	require.Nil(t, res.Manifest.ABI.GetMethod("put", 2))
+
+	_, err = c.GetContractState(util.Uint160{2})
+	require.ErrorIs(t, err, neorpc.NewRPCError("Unknown contract", ""))
+
+	c.inactive = true
+
+	_, err = c.GetContractState(cs.Hash)
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+func TestTokenBalances(t *testing.T) {
+	res := &result.NEP17Balances{
+		Balances: []result.NEP17Balance{
+			{Asset: gas.Hash, Amount: "100000000", Decimals: 8, Symbol: "GAS"},
+			{Asset: util.Uint160{1}, Amount: "42", Decimals: 0, Symbol: "TKN"},
+		},
+	}
+
+	bs, err := tokenBalances(res)
+	require.NoError(t, err)
+	require.Equal(t, []TokenBalance{
+		{Token: gas.Hash, Symbol: "GAS", Decimals: 8, Amount: big.NewInt(100000000)},
+		{Token: util.Uint160{1}, Symbol: "TKN", Decimals: 0, Amount: big.NewInt(42)},
+	}, bs)
+
+	res.Balances[1].Amount = "not a number"
+
+	_, err = tokenBalances(res)
+	require.Error(t, err)
+
+	c := &Client{switchLock: new(sync.RWMutex), inactive: true}
+
+	_, err = c.Balances()
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+type testTxHeightGetter struct {
+	calls  int
+	height uint32
+	err    error
+}
+
+func (x *testTxHeightGetter) GetTransactionHeight(util.Uint256) (uint32, error) {
+	x.calls++
+	return x.height, x.err
+}
+
+func TestCache_TransactionHeight(t *testing.T) {
+	c := newClientCache()
+	cli := &testTxHeightGetter{err: errors.New("unknown transaction")}
+	h := util.Uint256{1, 2, 3}
+
+	_, err := c.transactionHeight(cli, h)
+	require.Error(t, err)
+
+	cli.err = nil
+	cli.height = 42
+
+	for i := 0; i < 2; i++ {
+		height, err := c.transactionHeight(cli, h)
+		require.NoError(t, err)
+		require.EqualValues(t, 42, height)
+	}
+	require.Equal(t, 2, cli.calls, "errors must not be cached, heights must be")
+
+	c.invalidate()
+
+	_, err = c.transactionHeight(cli, h)
+	require.NoError(t, err)
+	require.Equal(t, 3, cli.calls)
+}
+
+func TestFaultError(t *testing.T) {
+	err := fmt.Errorf("could not invoke method: %w",
+		wrapNeoFSError(FaultError{State: "FAULT", Exception: "method not found"}))
+
+	require.EqualError(t, err, "could not invoke method: neofs error: "+
+		"chain/client: contract execution finished with state FAULT; exception: method not found")
+
+	var faultErr FaultError
+	require.ErrorAs(t, err, &faultErr)
+	require.Equal(t, "FAULT", faultErr.State)
+	require.Equal(t, "method not found", faultErr.Exception)
+
+	require.False(t, isTransientError(err))
+}
+
+func TestClient_WaitTx(t *testing.T) {
+	var (
+		halted  = util.Uint256{1}
+		faulted = util.Uint256{2}
+		failed  = util.Uint256{3}
+		unknown = util.Uint256{4}
+
+		// transactions appear after several polls
+		polls  = atomic.NewInt32(0)
+		height = atomic.NewUint32(10)
+	)
+
+	c := newTestRPCClient(t, func(method string, params []json.RawMessage) (interface{}, error) {
+		switch method {
+		case "getblockcount":
+			return height.Inc() - 1, nil
+		case "getapplicationlog":
+			var h util.Uint256
+			require.NoError(t, json.Unmarshal(params[0], &h))
+
+			if polls.Inc() < 3 || h.Equals(unknown) {
+				return nil, neorpc.WrapErrorWithData(neorpc.ErrUnknownScriptContainer, "failed to locate application log")
+			}
+
+			var st vmstate.State
+			switch h {
+			case halted:
+				st = vmstate.Halt
+			case faulted:
+				st = vmstate.Fault
+			default:
+				return nil, errors.New("some error")
+			}
+
+			return result.ApplicationLog{
+				Container:     h,
+				IsTransaction: true,
+				Executions: []state.Execution{{
+					Trigger: trigger.Application,
+					VMState: st,
+					Stack:   []stackitem.Item{},
+				}},
+			}, nil
+		default:
+			return nil, fmt.Errorf("unexpected method %s", method)
+		}
+	})
+	c.cfg.waitInterval = time.Millisecond
+
+	for _, tc := range []struct {
+		name string
+		h    util.Uint256
+		halt bool
+		err  bool
+	}{
+		{name: "halt", h: halted, halt: true},
+		{name: "fault", h: faulted},
+		{name: "error", h: failed, err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			polls.Store(0)
+
+			halt, err := c.WaitTx(context.Background(), tc.h, 0)
+			if tc.err {
+				require.Error(t, err)
+				require.NotErrorIs(t, err, ErrTxExpired)
+				require.EqualValues(t, 3, polls.Load())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.halt, halt)
+			require.EqualValues(t, 3, polls.Load())
+		})
+	}
+
+	t.Run("expiration", func(t *testing.T) {
+		polls.Store(0)
+
+		_, err := c.WaitTx(context.Background(), unknown, height.Load()+2)
+		require.ErrorIs(t, err, ErrTxExpired)
+		require.EqualValues(t, 4, polls.Load())
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := c.WaitTx(ctx, unknown, 0)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	c.inactive = true
+
+	_, err := c.WaitTx(context.Background(), util.Uint256{}, 0)
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+type testTxStateGetter struct {
+	height uint32
+	states map[util.Uint256]vmstate.State
+	err    error
+}
+
+func (x *testTxStateGetter) GetBlockCount() (uint32, error) {
+	return x.height, nil
+}
+
+func (x *testTxStateGetter) GetApplicationLog(h util.Uint256, _ *trigger.Type) (*result.ApplicationLog, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	st, ok := x.states[h]
+	if !ok {
+		return nil, neorpc.WrapErrorWithData(neorpc.ErrUnknownScriptContainer, "failed to locate application log")
+	}
+
+	return &result.ApplicationLog{
+		Container:  h,
+		Executions: []state.Execution{{VMState: st}},
+	}, nil
+}
+
+func TestTxState(t *testing.T) {
+	var (
+		halted  = util.Uint256{1}
+		faulted = util.Uint256{2}
+		unknown = util.Uint256{3}
+	)
+
+	cli := &testTxStateGetter{
+		height: 10,
+		states: map[util.Uint256]vmstate.State{halted: vmstate.Halt, faulted: vmstate.Fault},
+	}
+
+	halt, persisted, err := txState(cli, halted, 0)
+	require.NoError(t, err)
+	require.True(t, persisted)
+	require.True(t, halt)
+
+	halt, persisted, err = txState(cli, faulted, 0)
+	require.NoError(t, err)
+	require.True(t, persisted)
+	require.False(t, halt)
+
+	// unknown transaction is still valid
+	for _, vub := range []uint32{0, 10, 20} {
+		_, persisted, err = txState(cli, unknown, vub)
+		require.NoError(t, err)
+		require.False(t, persisted)
+	}
+
+	// vub block has been persisted without the transaction
+	_, _, err = txState(cli, unknown, 9)
+	require.ErrorIs(t, err, ErrTxExpired)
+
+	// persisted transaction is not expired
+	_, persisted, err = txState(cli, halted, 9)
+	require.NoError(t, err)
+	require.True(t, persisted)
+
+	// other errors are returned as is
+	cli.err = errors.New("any error")
+	_, _, err = txState(cli, unknown, 0)
+	require.ErrorIs(t, err, cli.err)
+}
+
+type testCommitteeGetter struct {
+	calls int
+	keys  keys.PublicKeys
+}
+
+func (x *testCommitteeGetter) GetCommittee() (keys.PublicKeys, error) {
+	x.calls++
+	return x.keys, nil
+}
+
+func TestCache_Committee(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	c := newClientCache()
+	cli := &testCommitteeGetter{keys: keys.PublicKeys{k.PublicKey()}}
+
+	t.Run("disabled", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := c.committee(cli, 0)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 2, cli.calls)
+	})
+
+	cli.calls = 0
+
+	for i := 0; i < 2; i++ {
+		res, err := c.committee(cli, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, cli.keys, res)
+	}
+	require.Equal(t, 1, cli.calls)
+
+	c.invalidate()
+
+	_, err = c.committee(cli, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, cli.calls)
+
+	c.invalidate()
+
+	_, err = c.committee(cli, time.Nanosecond)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = c.committee(cli, time.Nanosecond)
+	require.NoError(t, err)
+	require.Equal(t, 4, cli.calls, "expired list must be refreshed")
+}
+
+func TestGasTransfersScript(t *testing.T) {
+	from := util.Uint160{1}
+	receivers := []util.Uint160{{2}, {3}}
+
+	_, _, err := gasTransfersScript(from, nil, nil)
+	require.Error(t, err)
+
+	_, _, err = gasTransfersScript(from, receivers, []fixedn.Fixed8{1})
+	require.Error(t, err)
+
+	script, total, err := gasTransfersScript(from, receivers, []fixedn.Fixed8{1, 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, total)
+
+	w := io.NewBufBinWriter()
+	emit.AppCall(w.BinWriter, gas.Hash, "transfer", callflag.All, from, receivers[0], int64(1), nil)
+	emit.Opcodes(w.BinWriter, opcode.ASSERT)
+	emit.AppCall(w.BinWriter, gas.Hash, "transfer", callflag.All, from, receivers[1], int64(2), nil)
+	emit.Opcodes(w.BinWriter, opcode.ASSERT)
+	require.NoError(t, w.Err)
+	require.Equal(t, w.Bytes(), script)
+}
+
+func TestClient_Close(t *testing.T) {
+	c := &Client{
+		switchLock: new(sync.RWMutex),
+		closeChan:  make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	// call in progress
+	c.switchLock.RLock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, c.Close(ctx), context.DeadlineExceeded)
+
+	select {
+	case <-c.closeChan:
+		t.Fatal("client is closed before the call is finished")
+	default:
+	}
+
+	c.switchLock.RUnlock()
+
+	// notification loop is stopped
+	<-c.closeChan
+	close(c.done)
+
+	require.NoError(t, c.Close(context.Background()))
+	require.NoError(t, c.Close(context.Background()))
+
+	_, err := c.BlockCount()
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+func TestClient_InvokeTimeoutHungRPC(t *testing.T) {
+	// RPC node accepting the requests and never responding
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := new(websocket.Upgrader).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	endpoint := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	ws, err := rpcclient.NewWS(context.Background(), endpoint, rpcclient.Options{})
+	require.NoError(t, err)
+
+	c := &Client{
+		cache:         newClientCache(),
+		logger:        &logger.Logger{Logger: zap.NewNop()},
+		cfg:           cfg{ctx: context.Background(), invokeTimeout: 100 * time.Millisecond, pinnedEndpoint: endpoint},
+		switchLock:    new(sync.RWMutex),
+		notifications: make(chan rpcclient.Notification),
+		closeChan:     make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	c.endpoints.init([]Endpoint{{Address: endpoint}})
+	c.setClient(ws, nil)
+
+	go c.notificationLoop()
+
+	_, err = c.TestInvokeWithSigners(util.Uint160{1}, "method", []transaction.Signer{{}})
+	require.ErrorIs(t, err, ErrInvokeTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// abandoned invocation doesn't block closing
+	require.NoError(t, c.Close(ctx))
+
+	inactive := make(chan bool)
+	go func() { inactive <- c.IsInactive() }()
+
+	select {
+	case v := <-inactive:
+		require.True(t, v)
+	case <-ctx.Done():
+		t.Fatal("Client is blocked after closing")
+	}
+}
+
+// testRPCHandler returns the result of the JSON-RPC method call. Returned
+// *neorpc.Error is sent as the error response.
+type testRPCHandler func(method string, params []json.RawMessage) (interface{}, error)
+
+// newTestRPCClient returns the Client connected to the test WebSocket RPC
+// server answering the requests with handle. getversion requests are answered
+// by the server itself.
+func newTestRPCClient(t *testing.T, handle testRPCHandler) *Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := new(websocket.Upgrader).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var req struct {
+				ID     json.RawMessage   `json:"id"`
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+
+			var (
+				res    interface{}
+				resErr error
+			)
+
+			if req.Method == "getversion" {
+				res = result.Version{Protocol: result.Protocol{
+					Network:                     netmode.UnitTestNet,
+					MillisecondsPerBlock:        1000,
+					MaxValidUntilBlockIncrement: 100,
+					ValidatorsCount:             1,
+				}}
+			} else {
+				res, resErr = handle(req.Method, req.Params)
+			}
+
+			var resp neorpc.Response
+			resp.ID = req.ID
+			resp.JSONRPC = neorpc.JSONRPCVersion
+
+			if resErr != nil {
+				var rpcErr *neorpc.Error
+				if !errors.As(resErr, &rpcErr) {
+					rpcErr = neorpc.NewInternalServerError(resErr.Error())
+				}
+				resp.Error = rpcErr
+			} else {
+				resp.Result, err = json.Marshal(res)
+				if err != nil {
+					return
+				}
+			}
+
+			if err := conn.WriteJSON(resp); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	endpoint := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	ws, err := rpcclient.NewWS(context.Background(), endpoint, rpcclient.Options{})
+	require.NoError(t, err)
+	t.Cleanup(ws.Close)
+
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	c := &Client{
+		cache:      newClientCache(),
+		logger:     &logger.Logger{Logger: zap.NewNop()},
+		cfg:        *defaultConfig(),
+		switchLock: new(sync.RWMutex),
+		accSigner:  localSigner{key: key},
+		accAddr:    key.GetScriptHash(),
+	}
+	c.actorAcc = signerAccount(c.accSigner)
+	c.endpoints.init([]Endpoint{{Address: endpoint}})
+
+	act, err := newActor(ws, c.actorAcc, c.cfg)
+	require.NoError(t, err)
+
+	c.setClient(ws, act)
+
+	return c
+}
+
+func TestClient_InvokeWithResult(t *testing.T) {
+	var (
+		contract = util.Uint160{1, 2, 3}
+		stack    = []stackitem.Item{stackitem.Make(42), stackitem.Make("result")}
+		sent     *transaction.Transaction
+	)
+
+	c := newTestRPCClient(t, func(method string, params []json.RawMessage) (interface{}, error) {
+		switch method {
+		case "invokefunction":
+			var m string
+			require.NoError(t, json.Unmarshal(params[1], &m))
+
+			res := &result.Invoke{
+				State:       vmstate.Halt.String(),
+				GasConsumed: 100,
+				Script:      []byte{byte(opcode.RET)},
+				Stack:       stack,
+			}
+			if m == "fail" {
+				res.State = vmstate.Fault.String()
+				res.FaultException = "some exception"
+			}
+			return res, nil
+		case "getblockcount":
+			return 10, nil
+		case "calculatenetworkfee":
+			return result.NetworkFee{Value: 1000}, nil
+		case "sendrawtransaction":
+			var b []byte
+			require.NoError(t, json.Unmarshal(params[0], &b))
+
+			var err error
+			sent, err = transaction.NewTransactionFromBytes(b)
+			require.NoError(t, err)
+
+			return result.RelayResult{Hash: sent.Hash()}, nil
+		default:
+			return nil, fmt.Errorf("unexpected method %s", method)
+		}
+	})
+
+	txHash, res, err := c.InvokeWithResult(contract, 5, "method", int64(1))
+	require.NoError(t, err)
+	require.NotNil(t, sent)
+	require.Equal(t, sent.Hash(), txHash)
+	require.Equal(t, stack, res.Stack)
+	require.EqualValues(t, 100+5, sent.SystemFee)
+
+	// nothing is sent on fault, but the test run result is returned
+	sent = nil
+
+	_, res, err = c.InvokeWithResult(contract, 0, "fail")
+	var faultErr FaultError
+	require.ErrorAs(t, err, &faultErr)
+	require.Equal(t, "some exception", faultErr.Exception)
+	require.NotNil(t, res)
+	require.Equal(t, vmstate.Fault.String(), res.State)
+	require.Nil(t, sent)
+
+	c.inactive = true
+
+	txHash, res, err = c.InvokeWithResult(contract, 0, "method")
+	require.ErrorIs(t, err, ErrConnectionLost)
+	require.Zero(t, txHash)
+	require.Nil(t, res)
+}
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/notary"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"go.uber.org/zap"
+)
+
+// ErrNotEnoughSignatures is returned by Client.SendCommitteeTx if the
+// transaction has fewer committee signatures than required.
+var ErrNotEnoughSignatures = errors.New("not enough committee signatures")
+
+// CommitteeTx is a transaction requiring the committee multi-signature built
+// by Client.InvokeCommittee. Signatures of the committee members are collected
+// with AddSignature, the transaction can be sent by Client.SendCommitteeTx
+// when there are enough of them.
+type CommitteeTx struct {
+	tx  *transaction.Transaction
+	net netmode.Magic
+
+	// committee keys in the order of the multi-signature
+	// verification script
+	keys keys.PublicKeys
+	m    int
+	sigs [][]byte
+}
+
+// Transaction returns the transaction to be signed by the committee members.
+// The transaction is already signed by the Client account that pays the fees.
+func (x *CommitteeTx) Transaction() *transaction.Transaction {
+	return x.tx
+}
+
+// Hash returns the hash of the transaction. It doesn't depend on the
+// collected signatures.
+func (x *CommitteeTx) Hash() util.Uint256 {
+	return x.tx.Hash()
+}
+
+// AddSignature verifies the signature of the transaction made by the committee
+// member with the given key and adds it to the committee witness. Returns
+// true if there are enough signatures to send the transaction.
+func (x *CommitteeTx) AddSignature(pub *keys.PublicKey, sig []byte) (bool, error) {
+	i := -1
+	for j := range x.keys {
+		if x.keys[j].Equal(pub) {
+			i = j
+			break
+		}
+	}
+
+	if i < 0 {
+		return false, fmt.Errorf("key %x is not in the committee", pub.Bytes())
+	}
+
+	if !pub.VerifyHashable(sig, uint32(x.net), x.tx) {
+		return false, fmt.Errorf("invalid signature of %x", pub.Bytes())
+	}
+
+	x.sigs[i] = sig
+
+	return x.Complete(), nil
+}
+
+// Complete checks if there are enough signatures to send the transaction.
+func (x *CommitteeTx) Complete() bool {
+	return x.sigCount() >= x.m
+}
+
+func (x *CommitteeTx) sigCount() int {
+	var n int
+
+	for i := range x.sigs {
+		if x.sigs[i] != nil {
+			n++
+		}
+	}
+
+	return n
+}
+
+// invocationScript returns the invocation script of the committee witness
+// made of the first m signatures in the order of the keys.
+func (x *CommitteeTx) invocationScript() []byte {
+	w := io.NewBufBinWriter()
+
+	for i, n := 0, 0; i < len(x.sigs) && n < x.m; i++ {
+		if x.sigs[i] != nil {
+			emit.Bytes(w.BinWriter, x.sigs[i])
+			n++
+		}
+	}
+
+	return w.Bytes()
+}
+
+// InvokeCommittee builds the transaction invoking contract method on behalf
+// of the committee multi-signature account. The Client account is the sender
+// that pays the fees. If the Client account is a committee member, its
+// signature is added too. Other signatures are expected to be collected by
+// the caller, see CommitteeTx. Supported args types are the same as in Invoke.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) InvokeCommittee(contract util.Uint160, fee fixedn.Fixed8, method string, args ...interface{}) (*CommitteeTx, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	committee, err := c.cache.committee(c.client, c.cfg.committeeCacheTTL)
+	if err != nil {
+		return nil, fmt.Errorf("could not get committee: %w", err)
+	}
+
+	sort.Sort(committee)
+
+	m := sigCount(committee, true)
+
+	committeeAcc, err := notary.FakeMultisigAccount(m, committee)
+	if err != nil {
+		return nil, fmt.Errorf("could not create committee account: %w", err)
+	}
+
+	act, err := actor.New(c.client, []actor.SignerAccount{
+		{
+			Signer: transaction.Signer{
+				Account: c.actorAcc.ScriptHash(),
+				Scopes:  transaction.None,
+			},
+			Account: c.actorAcc,
+		},
+		{
+			Signer: transaction.Signer{
+				Account: committeeAcc.ScriptHash(),
+				Scopes:  transaction.CalledByEntry,
+			},
+			Account: committeeAcc,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create committee actor: %w", err)
+	}
+
+	r, err := act.Call(contract, method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not test invoke %s: %w", method, err)
+	}
+
+	tx, err := act.MakeUnsignedUncheckedRun(r.Script, r.GasConsumed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not build %s transaction: %w", method, err)
+	}
+
+	err = addFeeCheckerModifier(int64(fee))(r, tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not invoke %s: %w", method, err)
+	}
+
+	net := act.GetNetwork()
+
+	err = signTx(c.accSigner, net, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CommitteeTx{
+		tx:   tx,
+		net:  net,
+		keys: committee,
+		m:    m,
+		sigs: make([][]byte, len(committee)),
+	}
+
+	pub := c.accSigner.PublicKey()
+	if committee.Contains(pub) {
+		sig, err := c.accSigner.SignTx(net, tx)
+		if err != nil {
+			return nil, fmt.Errorf("could not sign transaction: %w", err)
+		}
+
+		if _, err = res.AddSignature(pub, sig); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// SendCommitteeTx sets the committee witness of the transaction built by
+// InvokeCommittee and sends it. Returns ErrNotEnoughSignatures if
+// the transaction is not complete.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) SendCommitteeTx(tx *CommitteeTx) (util.Uint256, error) {
+	if !tx.Complete() {
+		return util.Uint256{}, fmt.Errorf("%w: %d out of %d", ErrNotEnoughSignatures, tx.sigCount(), tx.m)
+	}
+
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return util.Uint256{}, ErrConnectionLost
+	}
+
+	tx.tx.Scripts[1].InvocationScript = tx.invocationScript()
+
+	h, err := c.client.SendRawTransaction(tx.tx)
+	if err != nil {
+		return util.Uint256{}, fmt.Errorf("could not send committee transaction: %w", err)
+	}
+
+	c.logger.Debug("committee transaction sent",
+		zap.Stringer("tx_hash", h.Reverse()))
+
+	return h, nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitteeTx(t *testing.T) {
+	const net = netmode.UnitTestNet
+
+	privs := make([]*keys.PrivateKey, 4)
+	for i := range privs {
+		var err error
+		privs[i], err = keys.NewPrivateKey()
+		require.NoError(t, err)
+	}
+
+	sort.Slice(privs, func(i, j int) bool {
+		return privs[i].PublicKey().Cmp(privs[j].PublicKey()) < 0
+	})
+
+	committee := make(keys.PublicKeys, len(privs))
+	for i := range privs {
+		committee[i] = privs[i].PublicKey()
+	}
+
+	tx := transaction.New([]byte{1}, 1)
+	tx.Scripts = make([]transaction.Witness, 2)
+
+	x := &CommitteeTx{
+		tx:   tx,
+		net:  net,
+		keys: committee,
+		m:    sigCount(committee, true),
+		sigs: make([][]byte, len(committee)),
+	}
+
+	require.Equal(t, 3, x.m)
+	require.False(t, x.Complete())
+
+	t.Run("not a member", func(t *testing.T) {
+		key, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		_, err = x.AddSignature(key.PublicKey(), key.SignHashable(uint32(net), tx))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_, err := x.AddSignature(committee[0], privs[1].SignHashable(uint32(net), tx))
+		require.Error(t, err)
+
+		_, err = x.AddSignature(committee[0], privs[0].SignHashable(uint32(netmode.MainNet), tx))
+		require.Error(t, err)
+	})
+
+	c := &Client{switchLock: new(sync.RWMutex), inactive: true}
+
+	sigs := make([][]byte, len(privs))
+	for _, i := range []int{3, 0, 1} {
+		sigs[i] = privs[i].SignHashable(uint32(net), tx)
+
+		_, err := c.SendCommitteeTx(x)
+		require.ErrorIs(t, err, ErrNotEnoughSignatures)
+
+		complete, err := x.AddSignature(committee[i], sigs[i])
+		require.NoError(t, err)
+		require.Equal(t, i == 1, complete)
+	}
+
+	require.True(t, x.Complete())
+
+	_, err := c.SendCommitteeTx(x)
+	require.ErrorIs(t, err, ErrConnectionLost)
+
+	w := io.NewBufBinWriter()
+	for _, i := range []int{0, 1, 3} {
+		emit.Bytes(w.BinWriter, sigs[i])
+	}
+
+	require.Equal(t, w.Bytes(), x.invocationScript())
+}
+
+func TestClient_InvokeCommittee(t *testing.T) {
+	c := &Client{switchLock: new(sync.RWMutex), inactive: true}
+
+	_, err := c.InvokeCommittee(util.Uint160{1}, 0, "method")
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
@@ -10,6 +10,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
 	"github.com/nspcc-dev/neo-go/pkg/util"
@@ -31,19 +32,45 @@ type cfg struct {
 
 	dialTimeout time.Duration // client dial timeout
 
+	invokeTimeout time.Duration
+
 	logger *logger.Logger // logging component
 
 	waitInterval time.Duration
+	waitJitter   float64
 
 	signer *transaction.Signer
 
-	endpoints []Endpoint
+	endpoints      []Endpoint
+	pinnedEndpoint string
 
 	singleCli *rpcclient.WSClient // neo-go client for single client mode
 
 	inactiveModeCb Callback
 
+	maxNotificationLag time.Duration
+
+	reconnectInterval time.Duration
+	recoverCb         Callback
+
 	switchInterval time.Duration
+
+	retry RetryPolicy
+
+	committeeCacheTTL time.Duration
+
+	metrics Metrics
+
+	healthThreshold time.Duration
+
+	latencyOrdering bool
+
+	checkGasBalance bool
+	minGasBalance   fixedn.Fixed8
+
+	accSigner Signer
+
+	contractCacheTTL time.Duration
 }
 
 const (
@@ -60,6 +87,9 @@ func defaultConfig() *cfg {
 		signer: &transaction.Signer{
 			Scopes: transaction.Global,
 		},
+		retry: RetryPolicy{
+			MaxAttempts: 1,
+		},
 	}
 }
 
@@ -67,27 +97,30 @@ func defaultConfig() *cfg {
 // Notary support should be enabled with EnableNotarySupport client
 // method separately.
 //
-// If private key is nil, it panics.
+// If private key is nil and the signer is not provided via WithAccountSigner,
+// it panics.
 //
 // Other values are set according to provided options, or by default:
 //   - client context: Background;
 //   - dial timeout: 5s;
+//   - invocation timeout: none;
 //   - blockchain network type: netmode.PrivNet;
 //   - signer with the global scope;
-//   - wait interval: 500ms;
-//   - logger: &logger.Logger{Logger: zap.L()}.
+//   - wait interval: 500ms without jitter;
+//   - logger: &logger.Logger{Logger: zap.L()};
+//   - retry policy: single attempt;
+//   - committee cache: disabled;
+//   - RPC node health check: disabled;
+//   - endpoints switch order: by priority;
+//   - endpoint pinning: disabled;
+//   - GAS balance check: disabled;
+//   - reconnection in inactive mode: disabled;
+//   - notification reader lag: unlimited.
 //
 // If desired option satisfies the default value, it can be omitted.
 // If multiple options of the same config value are supplied,
 // the option with the highest index in the arguments will be used.
 func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
-	if key == nil {
-		panic("empty private key")
-	}
-
-	acc := wallet.NewAccountFromPrivateKey(key)
-	accAddr := key.GetScriptHash()
-
 	// build default configuration
 	cfg := defaultConfig()
 
@@ -96,6 +129,35 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		opt(cfg)
 	}
 
+	var (
+		acc      *wallet.Account
+		actorAcc *wallet.Account
+	)
+
+	switch {
+	case cfg.accSigner == nil:
+		if key == nil {
+			panic("empty private key")
+		}
+
+		acc = wallet.NewAccountFromPrivateKey(key)
+		actorAcc = acc
+		cfg.accSigner = localSigner{key: key}
+	case key == nil:
+		actorAcc = signerAccount(cfg.accSigner)
+	default:
+		if !key.GetScriptHash().Equals(cfg.accSigner.ScriptHash()) {
+			return nil, errors.New("account of the signer differs from the private key one")
+		}
+
+		acc = wallet.NewAccountFromPrivateKey(key)
+		actorAcc = signerAccount(cfg.accSigner)
+	}
+
+	if cfg.pinnedEndpoint != "" {
+		cfg.endpoints = []Endpoint{{Address: cfg.pinnedEndpoint}}
+	}
+
 	if len(cfg.endpoints) == 0 {
 		return nil, errors.New("no endpoints were provided")
 	}
@@ -104,20 +166,27 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		cache:                  newClientCache(),
 		logger:                 cfg.logger,
 		acc:                    acc,
-		accAddr:                accAddr,
+		accAddr:                cfg.accSigner.ScriptHash(),
+		accSigner:              cfg.accSigner,
+		actorAcc:               actorAcc,
 		signer:                 cfg.signer,
 		cfg:                    *cfg,
 		switchLock:             &sync.RWMutex{},
 		notifications:          make(chan rpcclient.Notification),
-		subscribedEvents:       make(map[util.Uint160]string),
+		subscribedEvents:       make(map[notificationFilter]string),
 		subscribedNotaryEvents: make(map[util.Uint160]string),
 		closeChan:              make(chan struct{}),
+		done:                   make(chan struct{}),
+		blockReplay:            make(chan struct{}, 1),
 	}
 
 	cli.endpoints.init(cfg.endpoints)
 
-	var err error
-	var act *actor.Actor
+	var (
+		err error
+		ws  *rpcclient.WSClient
+		act *actor.Actor
+	)
 	if cfg.singleCli != nil {
 		// return client in single RPC node mode that uses
 		// predefined WS client
@@ -126,22 +195,26 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		// if extra endpoints were provided via options,
 		// they will be used in switch process, otherwise
 		// inactive mode will be enabled
-		cli.client = cfg.singleCli
+		ws = cfg.singleCli
 
-		act, err = newActor(cfg.singleCli, acc, *cfg)
+		act, err = newActor(cfg.singleCli, actorAcc, *cfg)
 		if err != nil {
 			return nil, fmt.Errorf("could not create RPC actor: %w", err)
 		}
 	} else {
-		cli.client, act, err = cli.newCli(cli.endpoints.list[0].Address)
+		ws, act, err = cli.newCli(cli.endpoints.list[0].Address)
 		if err != nil {
 			return nil, fmt.Errorf("could not create RPC client: %w", err)
 		}
 	}
-	cli.setActor(act)
+	cli.setClient(ws, act)
 
 	go cli.notificationLoop()
 
+	if cfg.healthThreshold > 0 {
+		go cli.healthCheckLoop()
+	}
+
 	return cli, nil
 }
 
@@ -164,7 +237,7 @@ func (c *Client) newCli(endpoint string) (*rpcclient.WSClient, *actor.Actor, err
 		return nil, nil, fmt.Errorf("WS client initialization: %w", err)
 	}
 
-	act, err := newActor(cli, c.acc, c.cfg)
+	act, err := newActor(cli, c.actorAcc, c.cfg)
 	if err != nil {
 		return nil, nil, fmt.Errorf("RPC actor creation: %w", err)
 	}
@@ -175,7 +248,7 @@ func (c *Client) newCli(endpoint string) (*rpcclient.WSClient, *actor.Actor, err
 func newActor(ws *rpcclient.WSClient, acc *wallet.Account, cfg cfg) (*actor.Actor, error) {
 	return actor.New(ws, []actor.SignerAccount{{
 		Signer: transaction.Signer{
-			Account:          acc.PrivateKey().PublicKey().GetScriptHash(),
+			Account:          acc.ScriptHash(),
 			Scopes:           cfg.signer.Scopes,
 			AllowedContracts: cfg.signer.AllowedContracts,
 			AllowedGroups:    cfg.signer.AllowedGroups,
@@ -188,7 +261,8 @@ func newClientCache() cache {
 	c, _ := lru.New(100) // returns error only if size is negative
 	return cache{
 		m:         &sync.RWMutex{},
-		txHeights: c,
+		txs:       c,
+		contracts: make(map[string]contractCacheEntry),
 	}
 }
 
@@ -258,6 +332,18 @@ func WithEndpoints(endpoints ...Endpoint) Option {
 	}
 }
 
+// WithPinnedEndpoint returns a client constructor option that makes Client
+// work with the given RPC endpoint only. Endpoints specified by WithEndpoints
+// are ignored, the Client never switches to another RPC node and goes to the
+// inactive mode right after the connection loss (it still can reconnect to the
+// same node, see WithReconnectInterval). It sacrifices availability for
+// determinism, e.g. for debugging and testing.
+func WithPinnedEndpoint(url string) Option {
+	return func(c *cfg) {
+		c.pinnedEndpoint = url
+	}
+}
+
 // WithSingleClient returns a client constructor option
 // that specifies single neo-go client and forces Client
 // to use it for requests.
@@ -287,3 +373,185 @@ func WithSwitchInterval(i time.Duration) Option {
 		c.switchInterval = i
 	}
 }
+
+// WithRetryPolicy returns a client constructor option that specifies
+// the policy of repeating contract invocations failed with transient
+// errors (e.g. full memory pool of the RPC node). If the repeated sending
+// of the transaction fails because it already exists, the transaction is
+// considered sent by the previous attempt.
+//
+// If option not provided, failed invocations are not repeated.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *cfg) {
+		c.retry = p
+	}
+}
+
+// WithCommitteeCacheTTL returns a client constructor option that specifies
+// for how long the committee list is cached by Client.Committee.
+//
+// If option not provided or the duration is non-positive, the list is
+// not cached.
+func WithCommitteeCacheTTL(ttl time.Duration) Option {
+	return func(c *cfg) {
+		c.committeeCacheTTL = ttl
+	}
+}
+
+// WithMetrics returns a client constructor option that specifies
+// the collector of the contract invocation metrics.
+//
+// Ignores nil value.
+func WithMetrics(m Metrics) Option {
+	return func(c *cfg) {
+		if m != nil {
+			c.metrics = m
+		}
+	}
+}
+
+// WithHealthThreshold returns a client constructor option that specifies
+// the maximum age of the latest block of the RPC node considered healthy
+// (see Client.CheckEndpointHealth). The current node is checked with the
+// threshold period and the Client switches to another healthy node if the
+// current one lags behind. Unhealthy nodes are also not chosen when the
+// Client switches to a higher priority one.
+//
+// If option not provided or the duration is non-positive, health is not checked.
+func WithHealthThreshold(d time.Duration) Option {
+	return func(c *cfg) {
+		c.healthThreshold = d
+	}
+}
+
+// WithLatencyOrdering returns a client constructor option that makes Client
+// try endpoints in the order of increasing round-trip time of the RPC calls
+// made to them (see Client.EndpointStats) when the connection is lost.
+// Endpoints without calls are tried last in the priority order. Client does
+// not return to the higher priority endpoint in this mode, so
+// WithSwitchInterval has no effect.
+//
+// If option not provided, endpoints are tried in the priority order.
+func WithLatencyOrdering() Option {
+	return func(c *cfg) {
+		c.latencyOrdering = true
+	}
+}
+
+// WithMinGasBalance returns a client constructor option that makes Client
+// check its GAS balance before sending the transaction in Invoke (and its
+// variations). If the balance does not cover the transaction fees plus min,
+// InsufficientGasError is returned and nothing is sent.
+//
+// Ignores negative value. If option not provided, the balance is not checked.
+func WithMinGasBalance(min fixedn.Fixed8) Option {
+	return func(c *cfg) {
+		if min >= 0 {
+			c.checkGasBalance = true
+			c.minGasBalance = min
+		}
+	}
+}
+
+// WithAccountSigner returns a client constructor option that specifies
+// the signer of the transactions sent by Invoke, InvokeBatch, TransferToken
+// (and their variations). In this case, the private key passed to New may be
+// nil, but notary support requires it. If the key is passed, it must belong
+// to the signer account.
+//
+// Ignores nil value. If option not provided, transactions are signed with
+// the private key passed to New.
+func WithAccountSigner(s Signer) Option {
+	return func(c *cfg) {
+		if s != nil {
+			c.accSigner = s
+		}
+	}
+}
+
+// WithContractCacheTTL returns a client constructor option that specifies
+// for how long the contract hashes resolved by Client.ResolveContract are
+// cached.
+//
+// If option not provided or the duration is non-positive, the hashes are
+// cached until the Client switches to another RPC node.
+func WithContractCacheTTL(ttl time.Duration) Option {
+	return func(c *cfg) {
+		c.contractCacheTTL = ttl
+	}
+}
+
+// WithWaitJitter returns a client constructor option that makes the pause
+// between the polls of Wait, WaitValidated and WaitTx random in the range of
+// the wait interval ± fraction of it. It spreads the load of the clients
+// polling the same RPC node.
+//
+// Ignores values out of (0, 1] range. If option not provided, the pause
+// is exactly the wait interval.
+func WithWaitJitter(fraction float64) Option {
+	return func(c *cfg) {
+		if fraction > 0 && fraction <= 1 {
+			c.waitJitter = fraction
+		}
+	}
+}
+
+// WithReconnectInterval returns a client constructor option that makes
+// inactive Client try to connect to the RPC endpoints with the specified
+// interval. On success, subscriptions are restored, the new notification
+// channel is opened (see Client.NotificationChannel) and the callback
+// specified by WithRecoverCallback is called.
+//
+// If option not provided or the interval is non-positive, the Client stays
+// inactive forever.
+func WithReconnectInterval(i time.Duration) Option {
+	return func(c *cfg) {
+		c.reconnectInterval = i
+	}
+}
+
+// WithRecoverCallback returns a client constructor option that specifies
+// a callback that is called when inactive Client reconnects to an RPC node,
+// see WithReconnectInterval.
+func WithRecoverCallback(cb Callback) Option {
+	return func(c *cfg) {
+		c.recoverCb = cb
+	}
+}
+
+// WithInvokeTimeout returns a client constructor option that limits the
+// duration of the contract invocations made by Invoke, InvokeContext,
+// InvokeWithResult, TestInvoke and TestInvokeWithSigners including all the
+// retries. Invocations exceeding the timeout fail with ErrInvokeTimeout.
+// Note that the transaction of the timed out Invoke may still be sent if
+// the timeout occurs during the sending, so retrying the invocation on
+// ErrInvokeTimeout may duplicate the transaction. Since RPC calls can't be
+// cancelled, the connection to the RPC node is dropped on the timeout and the
+// Client switches to another RPC node.
+//
+// If option not provided or the timeout is non-positive, the invocations
+// are limited by the RPC transport only.
+func WithInvokeTimeout(d time.Duration) Option {
+	return func(c *cfg) {
+		c.invokeTimeout = d
+	}
+}
+
+// WithMaxNotificationLag returns a client constructor option that limits
+// the time the Client waits for the reader of the notification channel (see
+// Client.NotificationChannel) to receive the block notification. If the reader
+// does not receive the block within the specified duration, the block is
+// dropped and the error is logged. The number of the dropped blocks is
+// returned by Client.DroppedNotifications. Contract and notary notifications
+// are never dropped.
+//
+// With block events resumption (see Client.SubscribeToBlocksFrom) the dropped
+// block is not considered sent and is sent again on the next replay.
+//
+// If option not provided or the duration is non-positive, the Client waits
+// for the reader forever.
+func WithMaxNotificationLag(d time.Duration) Option {
+	return func(c *cfg) {
+		c.maxNotificationLag = d
+	}
+}
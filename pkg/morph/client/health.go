@@ -0,0 +1,187 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// ErrStaleEndpoint is returned by Client.CheckEndpointHealth if the latest
+// block of the RPC node the Client is connected to is older than the health
+// threshold.
+var ErrStaleEndpoint = errors.New("RPC node serves stale chain")
+
+// blockReader is a part of RPC node interface used to check its freshness.
+type blockReader interface {
+	GetBlockCount() (uint32, error)
+	GetBlockHash(uint32) (util.Uint256, error)
+	GetBlockHeader(util.Uint256) (*block.Header, error)
+}
+
+// CheckEndpointHealth checks that the latest block of the RPC node the Client
+// is connected to has been produced no earlier than the health threshold
+// (see WithHealthThreshold) ago. Returns ErrStaleEndpoint if the node lags
+// behind. Always returns nil if the threshold is not set.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) CheckEndpointHealth() error {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return ErrConnectionLost
+	}
+
+	return c.checkHealth(c.client)
+}
+
+func (c *Client) checkHealth(r blockReader) error {
+	if c.cfg.healthThreshold <= 0 {
+		return nil
+	}
+
+	return checkBlockFreshness(r, c.cfg.healthThreshold, time.Now())
+}
+
+func checkBlockFreshness(r blockReader, threshold time.Duration, now time.Time) error {
+	count, err := r.GetBlockCount()
+	if err != nil {
+		return fmt.Errorf("can't get block count: %w", err)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("%w: no blocks", ErrStaleEndpoint)
+	}
+
+	h, err := r.GetBlockHash(count - 1)
+	if err != nil {
+		return fmt.Errorf("can't get block hash: %w", err)
+	}
+
+	hdr, err := r.GetBlockHeader(h)
+	if err != nil {
+		return fmt.Errorf("can't get block header: %w", err)
+	}
+
+	age := now.Sub(time.UnixMilli(int64(hdr.Timestamp)))
+	if age > threshold {
+		return fmt.Errorf("%w: block %d is %s old", ErrStaleEndpoint, hdr.Index, age.Truncate(time.Second))
+	}
+
+	return nil
+}
+
+// healthCheckLoop periodically checks the current RPC node and switches
+// to another healthy one if it serves stale chain.
+func (c *Client) healthCheckLoop() {
+	t := time.NewTicker(c.cfg.healthThreshold)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.cfg.ctx.Done():
+			return
+		case <-c.done:
+			return
+		case <-t.C:
+			err := c.CheckEndpointHealth()
+			if !errors.Is(err, ErrStaleEndpoint) {
+				// connection problems are handled
+				// by the notification loop
+				continue
+			}
+
+			c.logger.Warn("current RPC node is lagging behind, switching to another one",
+				zap.String("endpoint", c.CurrentEndpoint()),
+				zap.Error(err),
+			)
+
+			if !c.switchFromStale() {
+				c.logger.Warn("could not find healthy RPC node, staying on the current one")
+			}
+		}
+	}
+}
+
+// switchFromStale switches the Client to the first healthy RPC node other
+// than the current one. Returns false if there is no such node.
+func (c *Client) switchFromStale() bool {
+	c.switchLock.RLock()
+	endpointsCopy := make([]Endpoint, len(c.endpoints.list))
+	copy(endpointsCopy, c.endpoints.list)
+
+	curr := c.endpoints.curr
+	order := c.endpoints.switchOrder(c.cfg.latencyOrdering)
+	c.switchLock.RUnlock()
+
+	for _, i := range order {
+		e := endpointsCopy[i]
+		if i == curr {
+			continue
+		}
+
+		cli, act, err := c.newCli(e.Address)
+		if err != nil {
+			c.logger.Warn("could not create client to the RPC node",
+				zap.String("endpoint", e.Address),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if err = c.checkHealth(cli); err != nil {
+			cli.Close()
+			c.logger.Warn("RPC node is not healthy",
+				zap.String("endpoint", e.Address),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		c.switchLock.Lock()
+
+		// the node could have been switched
+		// in the other goroutine
+		if c.inactive || c.endpoints.curr != curr {
+			c.switchLock.Unlock()
+			cli.Close()
+			return true
+		}
+
+		if !c.restoreSubscriptions(cli, e.Address) {
+			c.switchLock.Unlock()
+			cli.Close()
+			continue
+		}
+
+		c.client.Close()
+		c.cache.invalidate()
+		c.setClient(cli, act)
+		c.endpoints.curr = i
+		c.switchCount.Inc()
+
+		startPrioritized := c.cfg.switchInterval != 0 && !c.cfg.latencyOrdering && !c.switchIsActive.Load() &&
+			e.Priority != c.endpoints.list[0].Priority
+		if startPrioritized {
+			c.switchIsActive.Store(true)
+		}
+
+		c.switchLock.Unlock()
+
+		c.logger.Info("switched to the healthy RPC node",
+			zap.String("endpoint", e.Address))
+
+		if startPrioritized {
+			go c.switchToMostPrioritized()
+		}
+
+		return true
+	}
+
+	return false
+}
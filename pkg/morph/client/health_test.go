@@ -0,0 +1,60 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlockReader struct {
+	count     uint32
+	timestamp time.Time
+	err       error
+}
+
+func (x testBlockReader) GetBlockCount() (uint32, error) {
+	return x.count, x.err
+}
+
+func (x testBlockReader) GetBlockHash(uint32) (util.Uint256, error) {
+	return util.Uint256{}, nil
+}
+
+func (x testBlockReader) GetBlockHeader(util.Uint256) (*block.Header, error) {
+	return &block.Header{
+		Index:     x.count - 1,
+		Timestamp: uint64(x.timestamp.UnixMilli()),
+	}, nil
+}
+
+func TestCheckBlockFreshness(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	const threshold = time.Minute
+
+	require.NoError(t, checkBlockFreshness(testBlockReader{count: 10, timestamp: now.Add(-threshold / 2)}, threshold, now))
+	require.NoError(t, checkBlockFreshness(testBlockReader{count: 10, timestamp: now.Add(-threshold)}, threshold, now))
+
+	err := checkBlockFreshness(testBlockReader{count: 10, timestamp: now.Add(-2 * threshold)}, threshold, now)
+	require.ErrorIs(t, err, ErrStaleEndpoint)
+
+	err = checkBlockFreshness(testBlockReader{}, threshold, now)
+	require.ErrorIs(t, err, ErrStaleEndpoint)
+
+	errRPC := errors.New("any error")
+	err = checkBlockFreshness(testBlockReader{err: errRPC}, threshold, now)
+	require.ErrorIs(t, err, errRPC)
+	require.NotErrorIs(t, err, ErrStaleEndpoint)
+}
+
+func TestClient_CheckEndpointHealth(t *testing.T) {
+	c := new(Client)
+	require.NoError(t, c.checkHealth(testBlockReader{}))
+
+	c.cfg.healthThreshold = time.Minute
+	require.ErrorIs(t, c.checkHealth(testBlockReader{}), ErrStaleEndpoint)
+	require.NoError(t, c.checkHealth(testBlockReader{count: 1, timestamp: time.Now()}))
+}
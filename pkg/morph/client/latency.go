@@ -0,0 +1,111 @@
+package client
+
+import (
+	"sort"
+	"time"
+)
+
+// rttWeight is a weight of the new round-trip time sample in the moving average.
+const rttWeight = 0.2
+
+// EndpointStats describes RPC endpoint of the Client with the statistics
+// of the calls made to it.
+type EndpointStats struct {
+	Address  string
+	Priority int
+
+	// RTT is an exponentially weighted moving average of the round-trip time
+	// of the successful RPC calls to the endpoint. Zero if there were no calls.
+	RTT time.Duration
+
+	// Samples is a number of the calls RTT is calculated from.
+	Samples uint64
+
+	// Current is true if the Client is connected to the endpoint.
+	Current bool
+}
+
+type endpointLatency struct {
+	rtt     time.Duration
+	samples uint64
+}
+
+// observe updates the moving average with the new sample.
+func (x *endpointLatency) observe(d time.Duration) {
+	if x.samples == 0 {
+		x.rtt = d
+	} else {
+		x.rtt += time.Duration(rttWeight * float64(d-x.rtt))
+	}
+
+	x.samples++
+}
+
+// observeRTT updates round-trip time statistics of the current endpoint with
+// the duration of the call started at start. Failed calls are not taken into
+// account.
+//
+// Must be called with switchLock held.
+func (c *Client) observeRTT(start time.Time, err error) {
+	if err != nil {
+		return
+	}
+
+	d := time.Since(start)
+
+	c.endpoints.latencyLock.Lock()
+	c.endpoints.latency[c.endpoints.curr].observe(d)
+	c.endpoints.latencyLock.Unlock()
+}
+
+// EndpointStats returns statistics of all the RPC endpoints of the Client
+// in the order of priority.
+func (c *Client) EndpointStats() []EndpointStats {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	c.endpoints.latencyLock.Lock()
+	defer c.endpoints.latencyLock.Unlock()
+
+	res := make([]EndpointStats, len(c.endpoints.list))
+	for i, e := range c.endpoints.list {
+		res[i] = EndpointStats{
+			Address:  e.Address,
+			Priority: e.Priority,
+			RTT:      c.endpoints.latency[i].rtt,
+			Samples:  c.endpoints.latency[i].samples,
+			Current:  i == c.endpoints.curr && !c.inactive,
+		}
+	}
+
+	return res
+}
+
+// switchOrder returns indices of the endpoints in the order they are tried
+// on switch. By default, it is the priority order. If byLatency is set,
+// endpoints with known round-trip time go first from the fastest one, the
+// rest follow in the priority order.
+func (e *endpoints) switchOrder(byLatency bool) []int {
+	res := make([]int, len(e.list))
+	for i := range res {
+		res[i] = i
+	}
+
+	if !byLatency {
+		return res
+	}
+
+	e.latencyLock.Lock()
+	defer e.latencyLock.Unlock()
+
+	sort.SliceStable(res, func(i, j int) bool {
+		li, lj := e.latency[res[i]], e.latency[res[j]]
+		if li.samples == 0 || lj.samples == 0 {
+			return li.samples != 0
+		}
+
+		return li.rtt < lj.rtt
+	})
+
+	return res
+}
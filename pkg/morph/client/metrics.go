@@ -0,0 +1,66 @@
+package client
+
+import (
+	"errors"
+	"time"
+)
+
+// InvokeStatus is a result of the contract method invocation.
+type InvokeStatus uint8
+
+const (
+	// InvokeHalt is a status of the successful invocation.
+	InvokeHalt InvokeStatus = iota
+	// InvokeFault is a status of the invocation finished with a VM state
+	// other than HALT (see FaultError).
+	InvokeFault
+	// InvokeConnectionLost is a status of the invocation failed because
+	// of the lost connection to the RPC node (see ErrConnectionLost).
+	InvokeConnectionLost
+	// InvokeFailed is a status of the invocation failed for any other reason.
+	InvokeFailed
+)
+
+// String implements fmt.Stringer.
+func (x InvokeStatus) String() string {
+	switch x {
+	case InvokeHalt:
+		return "HALT"
+	case InvokeFault:
+		return "FAULT"
+	case InvokeConnectionLost:
+		return "CONNECTION_LOST"
+	default:
+		return "FAILED"
+	}
+}
+
+// invokeStatus returns status of the invocation finished with err.
+func invokeStatus(err error) InvokeStatus {
+	var faultErr FaultError
+
+	switch {
+	case err == nil:
+		return InvokeHalt
+	case errors.As(err, &faultErr):
+		return InvokeFault
+	case errors.Is(err, ErrConnectionLost):
+		return InvokeConnectionLost
+	default:
+		return InvokeFailed
+	}
+}
+
+// Metrics collects statistics of the contract invocations made
+// by Client.Invoke and Client.TestInvoke (and their variations).
+type Metrics interface {
+	// OnInvoke is called after each invocation of the contract method
+	// with the invocation duration, status and error (nil on HALT).
+	OnInvoke(method string, dur time.Duration, status InvokeStatus, err error)
+}
+
+func (c *Client) reportInvoke(method string, start time.Time, err error) {
+	if c.cfg.metrics != nil {
+		c.cfg.metrics.OnInvoke(method, time.Since(start), invokeStatus(err), err)
+	}
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	method string
+	status InvokeStatus
+}
+
+func (x *testMetrics) OnInvoke(method string, _ time.Duration, status InvokeStatus, _ error) {
+	x.method = method
+	x.status = status
+}
+
+func TestInvokeStatus(t *testing.T) {
+	require.Equal(t, InvokeHalt, invokeStatus(nil))
+	require.Equal(t, InvokeFault, invokeStatus(wrapNeoFSError(FaultError{State: "FAULT"})))
+	require.Equal(t, InvokeConnectionLost, invokeStatus(ErrConnectionLost))
+	require.Equal(t, InvokeFailed, invokeStatus(errors.New("any")))
+}
+
+func TestClient_Metrics(t *testing.T) {
+	m := new(testMetrics)
+	c := &Client{
+		switchLock: new(sync.RWMutex),
+		inactive:   true,
+		cfg:        cfg{metrics: m},
+	}
+
+	_, err := c.TestInvoke(util.Uint160{}, "balanceOf")
+	require.ErrorIs(t, err, ErrConnectionLost)
+	require.Equal(t, "balanceOf", m.method)
+	require.Equal(t, InvokeConnectionLost, m.status)
+
+	c.cfg.metrics = nil
+	_, err = c.TestInvoke(util.Uint160{}, "balanceOf")
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
@@ -2,12 +2,18 @@ package client
 
 import (
 	"sort"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
 )
 
 // Endpoint represents morph endpoint together with its priority.
+//
+// Transport settings (TLS configuration, authentication headers) can't be
+// specified per endpoint: the underlying neo-go WebSocket client dials all the
+// endpoints with the default ones, so "wss://" endpoints are verified against
+// the system root certificates.
 type Endpoint struct {
 	Address  string
 	Priority int
@@ -16,6 +22,11 @@ type Endpoint struct {
 type endpoints struct {
 	curr int
 	list []Endpoint
+
+	// latencyLock protects latency, it is taken along with the
+	// shared switchLock on RPC calls.
+	latencyLock sync.Mutex
+	latency     []endpointLatency
 }
 
 func (e *endpoints) init(ee []Endpoint) {
@@ -25,6 +36,40 @@ func (e *endpoints) init(ee []Endpoint) {
 
 	e.curr = 0
 	e.list = ee
+	e.latency = make([]endpointLatency, len(ee))
+}
+
+// CurrentEndpoint returns address of the RPC node the Client is connected to.
+func (c *Client) CurrentEndpoint() string {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	return c.endpoints.list[c.endpoints.curr].Address
+}
+
+// CurrentEndpointIndex returns index of the RPC node the Client is connected
+// to in the list of endpoints sorted by priority.
+func (c *Client) CurrentEndpointIndex() int {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	return c.endpoints.curr
+}
+
+// IsInactive returns true if the Client has not been able to establish
+// connection to any of the RPC endpoints or has been closed. Any RPC call
+// of the inactive Client returns ErrConnectionLost.
+func (c *Client) IsInactive() bool {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	return c.inactive
+}
+
+// SwitchCount returns number of times the Client has switched to another
+// RPC node.
+func (c *Client) SwitchCount() uint64 {
+	return c.switchCount.Load()
 }
 
 func (c *Client) switchRPC() bool {
@@ -33,8 +78,15 @@ func (c *Client) switchRPC() bool {
 
 	c.client.Close()
 
-	// Iterate endpoints in the order of decreasing priority.
-	for c.endpoints.curr = range c.endpoints.list {
+	if c.cfg.pinnedEndpoint != "" {
+		// switching is disabled,
+		// see WithPinnedEndpoint
+		return false
+	}
+
+	// Iterate endpoints in the order of decreasing priority
+	// or increasing latency.
+	for _, c.endpoints.curr = range c.endpoints.switchOrder(c.cfg.latencyOrdering) {
 		newEndpoint := c.endpoints.list[c.endpoints.curr].Address
 		cli, act, err := c.newCli(newEndpoint)
 		if err != nil {
@@ -61,10 +113,10 @@ func (c *Client) switchRPC() bool {
 			continue
 		}
 
-		c.client = cli
-		c.setActor(act)
+		c.setClient(cli, act)
+		c.switchCount.Inc()
 
-		if c.cfg.switchInterval != 0 && !c.switchIsActive.Load() &&
+		if c.cfg.switchInterval != 0 && !c.cfg.latencyOrdering && !c.switchIsActive.Load() &&
 			c.endpoints.list[c.endpoints.curr].Priority != c.endpoints.list[0].Priority {
 			c.switchIsActive.Store(true)
 			go c.switchToMostPrioritized()
@@ -77,6 +129,8 @@ func (c *Client) switchRPC() bool {
 }
 
 func (c *Client) notificationLoop() {
+	defer close(c.done)
+
 	for {
 		c.switchLock.RLock()
 		nChan := c.client.Notifications
@@ -89,7 +143,7 @@ func (c *Client) notificationLoop() {
 
 			return
 		case <-c.closeChan:
-			_ = c.UnsubscribeAll()
+			// subscriptions are dropped along with the connection
 			c.close()
 
 			return
@@ -98,10 +152,24 @@ func (c *Client) notificationLoop() {
 			// state: if it is closed, the connection is
 			// considered to be lost
 			if !ok {
+				select {
+				case <-c.closeChan:
+					// connection has been lost concurrently
+					// with the Client closing
+					c.close()
+
+					return
+				default:
+				}
+
 				if closeErr := c.client.GetError(); closeErr != nil {
 					c.logger.Warn("switching to the next RPC node",
 						zap.String("reason", closeErr.Error()),
 					)
+				} else if c.connDropped.CAS(true, false) {
+					c.logger.Warn("switching to the next RPC node",
+						zap.String("reason", "invocation timeout"),
+					)
 				} else {
 					// neo-go client was closed by calling `Close`
 					// method that happens only when the client has
@@ -116,7 +184,9 @@ func (c *Client) notificationLoop() {
 					// switch client to inactive mode
 					c.inactiveMode()
 
-					return
+					if c.cfg.reconnectInterval <= 0 || !c.reconnect() {
+						return
+					}
 				}
 
 				// TODO(@carpawell): call here some callback retrieved in constructor
@@ -126,7 +196,17 @@ func (c *Client) notificationLoop() {
 				continue
 			}
 
-			c.notifications <- n
+			if !c.handleNotification(activeBlockGetter{c}, n) {
+				c.close()
+
+				return
+			}
+		case <-c.blockReplay:
+			if !c.replayToTip() {
+				c.close()
+
+				return
+			}
 		}
 	}
 }
@@ -141,6 +221,8 @@ mainLoop:
 		select {
 		case <-c.cfg.ctx.Done():
 			return
+		case <-c.done:
+			return
 		case <-t.C:
 			c.switchLock.RLock()
 			endpointsCopy := make([]Endpoint, len(c.endpoints.list))
@@ -173,22 +255,34 @@ mainLoop:
 					continue
 				}
 
-				if c.restoreSubscriptions(cli, tryE) {
-					c.switchLock.Lock()
+				if err = c.checkHealth(cli); err != nil {
+					cli.Close()
+					c.logger.Warn("higher priority node is not healthy",
+						zap.String("endpoint", tryE),
+						zap.Error(err),
+					)
+					continue
+				}
 
-					// higher priority node could have been
-					// connected in the other goroutine
-					if e.Priority >= c.endpoints.list[c.endpoints.curr].Priority {
-						cli.Close()
-						c.switchLock.Unlock()
-						return
-					}
+				c.switchLock.Lock()
+
+				// higher priority node could have been
+				// connected in the other goroutine or
+				// the Client could have been closed
+				if c.inactive || e.Priority >= c.endpoints.list[c.endpoints.curr].Priority {
+					cli.Close()
+					c.switchLock.Unlock()
+					return
+				}
 
+				// subscriptions are restored under the lock to
+				// not resubscribe to the concurrently removed sources
+				if c.restoreSubscriptions(cli, tryE) {
 					c.client.Close()
 					c.cache.invalidate()
-					c.client = cli
-					c.setActor(act)
+					c.setClient(cli, act)
 					c.endpoints.curr = i
+					c.switchCount.Inc()
 
 					c.switchLock.Unlock()
 
@@ -198,6 +292,9 @@ mainLoop:
 					return
 				}
 
+				c.switchLock.Unlock()
+				cli.Close()
+
 				c.logger.Warn("could not restore side chain subscriptions using node",
 					zap.String("endpoint", tryE),
 					zap.Error(err),
@@ -209,6 +306,14 @@ mainLoop:
 
 // close closes notification channel and wrapped WS client.
 func (c *Client) close() {
-	close(c.notifications)
+	c.closeNotifications()
 	c.client.Close()
 }
+
+// closeNotifications closes notification channel if it is not closed yet.
+func (c *Client) closeNotifications() {
+	if !c.notificationsClosed {
+		close(c.notifications)
+		c.notificationsClosed = true
+	}
+}
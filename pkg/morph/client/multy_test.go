@@ -1,10 +1,13 @@
 package client
 
 import (
+	"errors"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,3 +30,99 @@ func TestInitEndpoints(t *testing.T) {
 		prevValue = e.Priority
 	}
 }
+
+func TestClient_CurrentEndpoint(t *testing.T) {
+	c := &Client{switchLock: new(sync.RWMutex)}
+	c.endpoints.init([]Endpoint{
+		{Address: "ws://low:30333", Priority: 2},
+		{Address: "ws://high:30333", Priority: 1},
+	})
+
+	require.Equal(t, "ws://high:30333", c.CurrentEndpoint())
+	require.Zero(t, c.CurrentEndpointIndex())
+	require.Zero(t, c.SwitchCount())
+
+	c.endpoints.curr = 1
+	c.switchCount.Inc()
+
+	require.Equal(t, "ws://low:30333", c.CurrentEndpoint())
+	require.Equal(t, 1, c.CurrentEndpointIndex())
+	require.EqualValues(t, 1, c.SwitchCount())
+}
+
+func TestClient_IsInactive(t *testing.T) {
+	c := &Client{switchLock: new(sync.RWMutex)}
+	require.False(t, c.IsInactive())
+
+	c.inactive = true
+	require.True(t, c.IsInactive())
+}
+
+func TestEndpoints_SwitchOrder(t *testing.T) {
+	var e endpoints
+	e.init([]Endpoint{
+		{Address: "0", Priority: 0},
+		{Address: "1", Priority: 1},
+		{Address: "2", Priority: 2},
+		{Address: "3", Priority: 3},
+	})
+
+	require.Equal(t, []int{0, 1, 2, 3}, e.switchOrder(false))
+	require.Equal(t, []int{0, 1, 2, 3}, e.switchOrder(true))
+
+	e.latency[3].observe(10 * time.Millisecond)
+	e.latency[1].observe(50 * time.Millisecond)
+
+	require.Equal(t, []int{0, 1, 2, 3}, e.switchOrder(false))
+	require.Equal(t, []int{3, 1, 0, 2}, e.switchOrder(true))
+}
+
+func TestEndpointLatency(t *testing.T) {
+	var l endpointLatency
+
+	l.observe(100 * time.Millisecond)
+	require.Equal(t, 100*time.Millisecond, l.rtt)
+
+	l.observe(200 * time.Millisecond)
+	require.Equal(t, 120*time.Millisecond, l.rtt)
+	require.EqualValues(t, 2, l.samples)
+}
+
+func TestClient_EndpointStats(t *testing.T) {
+	c := &Client{switchLock: new(sync.RWMutex)}
+	c.endpoints.init([]Endpoint{
+		{Address: "ws://low:30333", Priority: 2},
+		{Address: "ws://high:30333", Priority: 1},
+	})
+
+	c.observeRTT(time.Now(), errors.New("any error"))
+	c.observeRTT(time.Now().Add(-time.Second), nil)
+
+	stats := c.EndpointStats()
+	require.Len(t, stats, 2)
+
+	require.Equal(t, "ws://high:30333", stats[0].Address)
+	require.True(t, stats[0].Current)
+	require.EqualValues(t, 1, stats[0].Samples)
+	require.GreaterOrEqual(t, stats[0].RTT, time.Second)
+
+	require.Equal(t, "ws://low:30333", stats[1].Address)
+	require.False(t, stats[1].Current)
+	require.Zero(t, stats[1].Samples)
+	require.Zero(t, stats[1].RTT)
+}
+
+func TestWithPinnedEndpoint(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	const pinned = "ws://127.0.0.1:2/ws"
+
+	_, err = New(key,
+		WithDialTimeout(100*time.Millisecond),
+		WithEndpoints(Endpoint{Address: "ws://127.0.0.1:1/ws"}),
+		WithPinnedEndpoint(pinned),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "127.0.0.1:2")
+}
@@ -44,6 +44,10 @@ var (
 	// ErrNNSRecordNotFound means that there is no such record in NNS contract.
 	ErrNNSRecordNotFound = errors.New("record has not been found in NNS contract")
 
+	// ErrDomainNotFound is returned by Client.ResolveContract if the name
+	// is not registered in NNS contract.
+	ErrDomainNotFound = errors.New("domain has not been found in NNS contract")
+
 	errEmptyResultStack = errors.New("returned result stack is empty")
 )
 
@@ -76,8 +80,15 @@ func (c *Client) NNSContractAddress(name string) (sh util.Uint160, err error) {
 	return sh, nil
 }
 
-// NNSHash returns NNS contract hash.
+// NNSHash returns NNS contract hash, see ResolveNNSHash.
 func (c *Client) NNSHash() (util.Uint160, error) {
+	return c.ResolveNNSHash()
+}
+
+// ResolveNNSHash returns NNS contract hash. The hash is resolved via the
+// management contract by the NNS contract ID and cached until the Client
+// switches to another RPC node or RefreshNNSHash is called.
+func (c *Client) ResolveNNSHash() (util.Uint160, error) {
 	c.switchLock.RLock()
 	defer c.switchLock.RUnlock()
 
@@ -85,18 +96,78 @@ func (c *Client) NNSHash() (util.Uint160, error) {
 		return util.Uint160{}, ErrConnectionLost
 	}
 
-	nnsHash := c.cache.nns()
+	return c.nnsHash()
+}
+
+// nnsHash returns cached NNS contract hash or resolves it.
+//
+// Must be called with switchLock held.
+func (c *Client) nnsHash() (util.Uint160, error) {
+	if nnsHash := c.cache.nns(); nnsHash != nil {
+		return *nnsHash, nil
+	}
+
+	return c.resolveNNSHash()
+}
+
+// ResolveContract returns hash of the contract registered in NNS with the
+// given name, e.g. NNSContainerContractName. Resolved hashes are cached,
+// see WithContractCacheTTL. Returns ErrDomainNotFound if there is no such
+// name in NNS.
+func (c *Client) ResolveContract(name string) (util.Uint160, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
 
-	if nnsHash == nil {
-		cs, err := c.client.GetContractStateByID(nnsContractID)
+	if c.inactive {
+		return util.Uint160{}, ErrConnectionLost
+	}
+
+	return c.cache.contractHash(name, c.cfg.contractCacheTTL, func() (util.Uint160, error) {
+		nnsHash, err := c.nnsHash()
 		if err != nil {
-			return util.Uint160{}, fmt.Errorf("NNS contract state: %w", err)
+			return util.Uint160{}, err
 		}
 
-		c.cache.setNNSHash(cs.Hash)
-		nnsHash = &cs.Hash
+		h, err := nnsResolve(c.client, nnsHash, name)
+		if err != nil {
+			if errors.Is(err, ErrNNSRecordNotFound) {
+				return util.Uint160{}, fmt.Errorf("%w: %s", ErrDomainNotFound, name)
+			}
+
+			return util.Uint160{}, fmt.Errorf("NNS.resolve %s: %w", name, err)
+		}
+
+		return h, nil
+	})
+}
+
+// RefreshNNSHash is the same as ResolveNNSHash but always resolves NNS
+// contract hash ignoring the cached one, e.g. after contract redeployment.
+func (c *Client) RefreshNNSHash() (util.Uint160, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return util.Uint160{}, ErrConnectionLost
 	}
-	return *nnsHash, nil
+
+	c.cache.dropNNSHash()
+
+	return c.resolveNNSHash()
+}
+
+// resolveNNSHash resolves NNS contract hash and caches it.
+//
+// Must be called with switchLock held.
+func (c *Client) resolveNNSHash() (util.Uint160, error) {
+	cs, err := c.client.GetContractStateByID(nnsContractID)
+	if err != nil {
+		return util.Uint160{}, fmt.Errorf("NNS contract state: %w", err)
+	}
+
+	c.cache.setNNSHash(cs.Hash)
+
+	return cs.Hash, nil
 }
 
 func nnsResolveItem(c *rpcclient.WSClient, nnsHash util.Uint160, domain string) (stackitem.Item, error) {
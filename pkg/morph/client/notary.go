@@ -83,6 +83,10 @@ func (c *Client) EnableNotarySupport(opts ...NotaryOption) error {
 		return ErrConnectionLost
 	}
 
+	if c.acc == nil {
+		return errors.New("notary support requires the private key of the account")
+	}
+
 	cfg := defaultNotaryConfig(c)
 
 	for _, opt := range opts {
@@ -475,7 +479,7 @@ func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint
 
 	// check invocation state
 	if test.State != HaltState {
-		return wrapNeoFSError(&notHaltStateError{state: test.State, exception: test.FaultException})
+		return wrapNeoFSError(FaultError{State: test.State, Exception: test.FaultException})
 	}
 
 	// if test invocation failed, then return error
@@ -891,13 +895,5 @@ func (c *Client) CalculateNonceAndVUB(hash util.Uint256) (nonce uint32, vub uint
 }
 
 func (c *Client) getTransactionHeight(h util.Uint256) (uint32, error) {
-	if rh, ok := c.cache.txHeights.Get(h); ok {
-		return rh.(uint32), nil
-	}
-	height, err := c.client.GetTransactionHeight(h)
-	if err != nil {
-		return 0, err
-	}
-	c.cache.txHeights.Add(h, height)
-	return height, nil
+	return c.cache.transactionHeight(c.client, h)
 }
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"go.uber.org/zap"
@@ -8,13 +11,36 @@ import (
 
 // Close closes connection to the remote side making
 // this client instance unusable. Closes notification
-// channel returned from Client.NotificationChannel(),
-// Removes all subscription.
-func (c *Client) Close() {
-	// closing should be done via the channel
-	// to prevent switching to another RPC node
-	// in the notification loop
-	c.closeChan <- struct{}{}
+// channel returned from Client.NotificationChannel().
+//
+// New calls are not accepted after Close is called: they
+// return ErrConnectionLost. Calls in progress are waited
+// for before closing the connection. If ctx is done before
+// that, Close returns an error wrapping ctx.Err() and the
+// connection is closed in background when the calls are
+// finished. Close may be called multiple times.
+func (c *Client) Close(ctx context.Context) error {
+	c.closeOnce.Do(func() {
+		go func() {
+			// exclusive lock waits for the calls in progress
+			c.switchLock.Lock()
+			c.inactive = true
+			c.closed = true
+			c.switchLock.Unlock()
+
+			// closing should be done via the channel
+			// to prevent switching to another RPC node
+			// in the notification loop
+			close(c.closeChan)
+		}()
+	})
+
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("could not wait for calls in progress: %w", ctx.Err())
+	}
 }
 
 // SubscribeForExecutionNotifications adds subscription for notifications
@@ -23,6 +49,18 @@ func (c *Client) Close() {
 // Returns ErrConnectionLost if client has not been able to establish
 // connection to any of passed RPC endpoints.
 func (c *Client) SubscribeForExecutionNotifications(contract util.Uint160) error {
+	return c.SubscribeForNotifications(contract, "")
+}
+
+// SubscribeForNotifications is the same as SubscribeForExecutionNotifications
+// but subscribes only for the notifications with the given name, so others are
+// filtered out by the RPC node. Empty name means all the notifications of the
+// contract. Subscription for all the notifications of the contract replaces
+// the subscriptions for the particular ones.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) SubscribeForNotifications(contract util.Uint160, eventName string) error {
 	c.switchLock.Lock()
 	defer c.switchLock.Unlock()
 
@@ -30,22 +68,61 @@ func (c *Client) SubscribeForExecutionNotifications(contract util.Uint160) error
 		return ErrConnectionLost
 	}
 
-	_, subscribed := c.subscribedEvents[contract]
-	if subscribed {
+	flt := notificationFilter{contract: contract, name: eventName}
+
+	if _, subscribed := c.subscribedEvents[flt]; subscribed {
 		// no need to subscribe one more time
 		return nil
 	}
 
-	id, err := c.client.SubscribeForExecutionNotifications(&contract, nil)
+	if _, subscribed := c.subscribedEvents[notificationFilter{contract: contract}]; subscribed {
+		// all the notifications of the contract are
+		// already received
+		return nil
+	}
+
+	id, err := c.client.SubscribeForExecutionNotifications(flt.params())
 	if err != nil {
 		return err
 	}
 
-	c.subscribedEvents[contract] = id
+	c.subscribedEvents[flt] = id
+
+	if eventName == "" {
+		// drop the particular subscriptions to
+		// not receive the same notification twice
+		for f, id := range c.subscribedEvents {
+			if f.contract.Equals(contract) && f.name != "" {
+				if err = c.client.Unsubscribe(id); err != nil {
+					return err
+				}
+
+				delete(c.subscribedEvents, f)
+			}
+		}
+	}
 
 	return nil
 }
 
+// notificationFilter describes subscription for contract notifications.
+type notificationFilter struct {
+	contract util.Uint160
+	name     string // empty for all the notifications
+}
+
+// params returns the parameters of the WS subscription.
+func (x notificationFilter) params() (*util.Uint160, *string) {
+	contract := x.contract
+	if x.name == "" {
+		return &contract, nil
+	}
+
+	name := x.name
+
+	return &contract, &name
+}
+
 // SubscribeForNewBlocks adds subscription for new block events to this
 // instance of client.
 //
@@ -74,6 +151,38 @@ func (c *Client) SubscribeForNewBlocks() error {
 	return nil
 }
 
+// SubscribeToBlocksFrom is the same as SubscribeForNewBlocks but also sends
+// block events starting from the given height up to the current chain tip to
+// the notification channel before the new ones. Blocks produced while Client
+// switches to another RPC node are sent too, so each block is sent once in
+// the order of heights. Calling it once more resets the starting height.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) SubscribeToBlocksFrom(height uint32) error {
+	c.switchLock.Lock()
+	defer c.switchLock.Unlock()
+
+	if c.inactive {
+		return ErrConnectionLost
+	}
+
+	if !c.subscribedToNewBlocks {
+		_, err := c.client.SubscribeForNewBlocks(nil)
+		if err != nil {
+			return err
+		}
+
+		c.subscribedToNewBlocks = true
+	}
+
+	c.nextBlock.Store(height)
+	c.resumeBlocks.Store(true)
+	c.triggerBlockReplay()
+
+	return nil
+}
+
 // SubscribeForNotaryRequests adds subscription for notary request payloads
 // addition or removal events to this instance of client. Passed txSigner is
 // used as filter: subscription is only for the notary requests that must be
@@ -109,7 +218,8 @@ func (c *Client) SubscribeForNotaryRequests(txSigner util.Uint160) error {
 	return nil
 }
 
-// UnsubscribeContract removes subscription for given contract event stream.
+// UnsubscribeContract removes all the subscriptions for given contract
+// event stream including the ones filtered by the notification name.
 //
 // Returns ErrConnectionLost if client has not been able to establish
 // connection to any of passed RPC endpoints.
@@ -121,19 +231,18 @@ func (c *Client) UnsubscribeContract(contract util.Uint160) error {
 		return ErrConnectionLost
 	}
 
-	_, subscribed := c.subscribedEvents[contract]
-	if !subscribed {
-		// no need to unsubscribe contract
-		// without subscription
-		return nil
-	}
+	for flt, id := range c.subscribedEvents {
+		if !flt.contract.Equals(contract) {
+			continue
+		}
 
-	err := c.client.Unsubscribe(c.subscribedEvents[contract])
-	if err != nil {
-		return err
-	}
+		err := c.client.Unsubscribe(id)
+		if err != nil {
+			return err
+		}
 
-	delete(c.subscribedEvents, contract)
+		delete(c.subscribedEvents, flt)
+	}
 
 	return nil
 }
@@ -196,7 +305,7 @@ func (c *Client) UnsubscribeAll() error {
 		return err
 	}
 
-	c.subscribedEvents = make(map[util.Uint160]string)
+	c.subscribedEvents = make(map[notificationFilter]string)
 	c.subscribedNotaryEvents = make(map[util.Uint160]string)
 	c.subscribedToNewBlocks = false
 
@@ -204,11 +313,18 @@ func (c *Client) UnsubscribeAll() error {
 }
 
 // restoreSubscriptions restores subscriptions according to
-// cached information about them.
+// cached information about them. Cached subscription IDs are
+// updated only if all the subscriptions have been restored, so
+// the sources unsubscribed before are never restored.
+//
+// Must be called with switchLock held exclusively.
 func (c *Client) restoreSubscriptions(cli *rpcclient.WSClient, endpoint string) bool {
 	var (
 		err error
 		id  string
+
+		subscribedEvents       = make(map[notificationFilter]string, len(c.subscribedEvents))
+		subscribedNotaryEvents = make(map[util.Uint160]string, len(c.subscribedNotaryEvents))
 	)
 
 	// new block events restoration
@@ -225,8 +341,8 @@ func (c *Client) restoreSubscriptions(cli *rpcclient.WSClient, endpoint string)
 	}
 
 	// notification events restoration
-	for contract := range c.subscribedEvents {
-		id, err = cli.SubscribeForExecutionNotifications(&contract, nil)
+	for flt := range c.subscribedEvents {
+		id, err = cli.SubscribeForExecutionNotifications(flt.params())
 		if err != nil {
 			c.logger.Error("could not restore notification subscription after RPC switch",
 				zap.String("endpoint", endpoint),
@@ -236,7 +352,7 @@ func (c *Client) restoreSubscriptions(cli *rpcclient.WSClient, endpoint string)
 			return false
 		}
 
-		c.subscribedEvents[contract] = id
+		subscribedEvents[flt] = id
 	}
 
 	// notary notification events restoration
@@ -252,9 +368,16 @@ func (c *Client) restoreSubscriptions(cli *rpcclient.WSClient, endpoint string)
 				return false
 			}
 
-			c.subscribedNotaryEvents[signer] = id
+			subscribedNotaryEvents[signer] = id
 		}
 	}
 
+	c.subscribedEvents = subscribedEvents
+	c.subscribedNotaryEvents = subscribedNotaryEvents
+
+	// blocks produced during the switch are sent
+	// before the new ones
+	c.triggerBlockReplay()
+
 	return true
 }
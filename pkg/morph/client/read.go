@@ -0,0 +1,190 @@
+package client
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+)
+
+// ReadInt64 test-invokes the contract method returning a single integer.
+func (c *Client) ReadInt64(contract util.Uint160, method string, args ...interface{}) (int64, error) {
+	item, err := c.readSingle(contract, method, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := IntFromStackItem(item)
+	if err != nil {
+		return 0, fmt.Errorf("could not get integer stack item (%s): %w", method, err)
+	}
+
+	return res, nil
+}
+
+// ReadBool test-invokes the contract method returning a single boolean.
+func (c *Client) ReadBool(contract util.Uint160, method string, args ...interface{}) (bool, error) {
+	item, err := c.readSingle(contract, method, args...)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := BoolFromStackItem(item)
+	if err != nil {
+		return false, fmt.Errorf("could not get bool stack item (%s): %w", method, err)
+	}
+
+	return res, nil
+}
+
+// ReadBytes test-invokes the contract method returning a single byte array.
+func (c *Client) ReadBytes(contract util.Uint160, method string, args ...interface{}) ([]byte, error) {
+	item, err := c.readSingle(contract, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := BytesFromStackItem(item)
+	if err != nil {
+		return nil, fmt.Errorf("could not get byte array stack item (%s): %w", method, err)
+	}
+
+	return res, nil
+}
+
+func (c *Client) readSingle(contract util.Uint160, method string, args ...interface{}) (stackitem.Item, error) {
+	items, err := c.TestInvoke(contract, method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not perform test invocation (%s): %w", method, err)
+	}
+
+	return singleStackItem(method, items)
+}
+
+// singleStackItem returns the only item of the method result stack.
+func singleStackItem(method string, items []stackitem.Item) (stackitem.Item, error) {
+	if ln := len(items); ln != 1 {
+		return nil, fmt.Errorf("unexpected stack item count (%s): %d", method, ln)
+	}
+
+	return items[0], nil
+}
+
+var uint160Type = reflect.TypeOf(util.Uint160{})
+
+// DecodeStruct test-invokes the contract method returning a single array or
+// struct and decodes its items into the fields of the structure dst points
+// to. Items are mapped to the fields positionally, so the number of items must
+// be equal to the number of fields. Supported field types are int64, []byte,
+// string, bool and util.Uint160, all the fields must be exported.
+func (c *Client) DecodeStruct(contract util.Uint160, method string, dst interface{}, args ...interface{}) error {
+	item, err := c.readSingle(contract, method, args...)
+	if err != nil {
+		return err
+	}
+
+	err = decodeStruct(item, dst)
+	if err != nil {
+		return fmt.Errorf("could not decode struct stack item (%s): %w", method, err)
+	}
+
+	return nil
+}
+
+// decodeStruct sets the fields of the structure dst points to from
+// the array stack item.
+func decodeStruct(item stackitem.Item, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("destination must be a non-nil pointer to a struct, got %T", dst)
+	}
+
+	if t := item.Type(); t != stackitem.ArrayT && t != stackitem.StructT {
+		return fmt.Errorf("%s is not an array type", t)
+	}
+
+	items, err := ArrayFromStackItem(item)
+	if err != nil {
+		return err
+	}
+
+	v = v.Elem()
+	t := v.Type()
+
+	if ln := t.NumField(); len(items) != ln {
+		return fmt.Errorf("unexpected number of items: %d instead of %d fields of %s", len(items), ln, t)
+	}
+
+	for i := range items {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			return fmt.Errorf("field %s is not exported", f.Name)
+		}
+
+		err = setField(v.Field(i), items[i])
+		if err != nil {
+			return fmt.Errorf("field #%d (%s): %w", i, f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// setField sets the value of the struct field from the stack item.
+func setField(f reflect.Value, item stackitem.Item) error {
+	if f.Type() == uint160Type {
+		b, err := BytesFromStackItem(item)
+		if err != nil {
+			return err
+		}
+
+		u, err := util.Uint160DecodeBytesBE(b)
+		if err != nil {
+			return err
+		}
+
+		f.Set(reflect.ValueOf(u))
+
+		return nil
+	}
+
+	switch f.Kind() {
+	case reflect.Int64:
+		n, err := IntFromStackItem(item)
+		if err != nil {
+			return err
+		}
+
+		f.SetInt(n)
+	case reflect.Bool:
+		b, err := BoolFromStackItem(item)
+		if err != nil {
+			return err
+		}
+
+		f.SetBool(b)
+	case reflect.String:
+		s, err := StringFromStackItem(item)
+		if err != nil {
+			return err
+		}
+
+		f.SetString(s)
+	case reflect.Slice:
+		if f.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported field type %s", f.Type())
+		}
+
+		b, err := BytesFromStackItem(item)
+		if err != nil {
+			return err
+		}
+
+		f.SetBytes(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type())
+	}
+
+	return nil
+}
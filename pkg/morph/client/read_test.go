@@ -0,0 +1,90 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleStackItem(t *testing.T) {
+	_, err := singleStackItem("method", nil)
+	require.Error(t, err)
+
+	_, err = singleStackItem("method", []stackitem.Item{stackitem.Make(1), stackitem.Make(2)})
+	require.Error(t, err)
+
+	item, err := singleStackItem("method", []stackitem.Item{stackitem.Make(1)})
+	require.NoError(t, err)
+	require.Equal(t, stackitem.Make(1), item)
+}
+
+func TestDecodeStruct(t *testing.T) {
+	type testStruct struct {
+		Int   int64
+		Bytes []byte
+		Str   string
+		Bool  bool
+		Hash  util.Uint160
+	}
+
+	hash := util.Uint160{1, 2, 3}
+	items := []stackitem.Item{
+		stackitem.Make(42),
+		stackitem.Make([]byte{1, 2}),
+		stackitem.Make("str"),
+		stackitem.Make(true),
+		stackitem.Make(hash.BytesBE()),
+	}
+
+	t.Run("array", func(t *testing.T) {
+		var res testStruct
+		require.NoError(t, decodeStruct(stackitem.NewArray(items), &res))
+		require.Equal(t, testStruct{
+			Int:   42,
+			Bytes: []byte{1, 2},
+			Str:   "str",
+			Bool:  true,
+			Hash:  hash,
+		}, res)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		var res testStruct
+		require.NoError(t, decodeStruct(stackitem.NewStruct(items), &res))
+		require.Equal(t, hash, res.Hash)
+	})
+
+	t.Run("arity mismatch", func(t *testing.T) {
+		var res testStruct
+		require.Error(t, decodeStruct(stackitem.NewArray(items[:4]), &res))
+		require.Error(t, decodeStruct(stackitem.NewArray(append(items, stackitem.Make(1))), &res))
+	})
+
+	t.Run("invalid item", func(t *testing.T) {
+		var res testStruct
+		require.Error(t, decodeStruct(stackitem.Make(1), &res))
+
+		wrong := make([]stackitem.Item, len(items))
+		copy(wrong, items)
+		wrong[0] = stackitem.NewArray(nil)
+		require.Error(t, decodeStruct(stackitem.NewArray(wrong), &res))
+
+		copy(wrong, items)
+		wrong[4] = stackitem.Make([]byte{1})
+		require.Error(t, decodeStruct(stackitem.NewArray(wrong), &res))
+	})
+
+	t.Run("invalid destination", func(t *testing.T) {
+		var res testStruct
+		require.Error(t, decodeStruct(stackitem.NewArray(items), res))
+		require.Error(t, decodeStruct(stackitem.NewArray(items), (*testStruct)(nil)))
+
+		var unsupported struct{ Int int32 }
+		require.Error(t, decodeStruct(stackitem.NewArray(items[:1]), &unsupported))
+
+		var unexported struct{ i int64 }
+		require.Error(t, decodeStruct(stackitem.NewArray(items[:1]), &unexported))
+	})
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
+	"go.uber.org/zap"
+)
+
+// reconnect tries to connect to any RPC endpoint with the reconnect interval
+// while the Client is inactive. Returns false if the Client is closed before
+// the connection is established.
+func (c *Client) reconnect() bool {
+	t := time.NewTicker(c.cfg.reconnectInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.cfg.ctx.Done():
+			return false
+		case <-c.closeChan:
+			return false
+		case <-t.C:
+			if c.restoreFromInactive() {
+				return true
+			}
+		}
+	}
+}
+
+// restoreFromInactive connects inactive Client to the first available RPC
+// endpoint, restores subscriptions and opens the new notification channel.
+// Returns false if no endpoint is available.
+//
+// Must be called from the notification loop only.
+func (c *Client) restoreFromInactive() bool {
+	for _, i := range c.endpoints.switchOrder(c.cfg.latencyOrdering) {
+		endpoint := c.endpoints.list[i].Address
+
+		cli, act, err := c.newCli(endpoint)
+		if err != nil {
+			c.logger.Debug("could not reconnect to the RPC node",
+				zap.String("endpoint", endpoint),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		c.switchLock.Lock()
+
+		if c.closed {
+			c.switchLock.Unlock()
+			cli.Close()
+			return false
+		}
+
+		if !c.restoreSubscriptions(cli, endpoint) {
+			c.switchLock.Unlock()
+			cli.Close()
+			continue
+		}
+
+		c.cache.invalidate()
+		c.setClient(cli, act)
+		c.endpoints.curr = i
+		c.notifications = make(chan rpcclient.Notification)
+		c.notificationsClosed = false
+		c.inactive = false
+
+		c.switchLock.Unlock()
+
+		c.logger.Info("connection to the RPC node has been restored",
+			zap.String("endpoint", endpoint))
+
+		if c.cfg.recoverCb != nil {
+			c.cfg.recoverCb()
+		}
+
+		return true
+	}
+
+	return false
+}
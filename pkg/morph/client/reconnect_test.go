@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestClient_Reconnect(t *testing.T) {
+	c := &Client{
+		logger:     &logger.Logger{Logger: zap.NewNop()},
+		switchLock: new(sync.RWMutex),
+		closeChan:  make(chan struct{}),
+		inactive:   true,
+		cfg: cfg{
+			ctx:               context.Background(),
+			dialTimeout:       100 * time.Millisecond,
+			reconnectInterval: time.Millisecond,
+		},
+	}
+	c.endpoints.init([]Endpoint{{Address: "ws://127.0.0.1:1/ws"}})
+
+	require.False(t, c.restoreFromInactive())
+	require.True(t, c.IsInactive())
+
+	res := make(chan bool)
+	go func() { res <- c.reconnect() }()
+
+	time.Sleep(10 * time.Millisecond)
+	close(c.closeChan)
+
+	require.False(t, <-res)
+	require.True(t, c.IsInactive())
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/neorpc"
+	"go.uber.org/zap"
+)
+
+// RetryPolicy describes how RPC calls failed with transient errors are
+// repeated. Delay between the attempts starts from BaseDelay and is multiplied
+// by Multiplier after each attempt.
+type RetryPolicy struct {
+	// MaxAttempts is a maximum number of the call attempts, values less
+	// than 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is a delay before the second attempt.
+	BaseDelay time.Duration
+
+	// Multiplier is a delay multiplier, values less than 1 are treated as 1.
+	Multiplier float64
+}
+
+// isTransientError checks whether the call failed with err may succeed
+// if repeated. Only the errors known to be temporary are transient: full
+// memory pool and internal errors of the RPC node, and transport failures.
+// Any other error (e.g. transaction validation failure or FAULT state of the
+// contract execution) is permanent.
+func isTransientError(err error) bool {
+	var (
+		rpcErr *neorpc.Error
+		netErr net.Error
+	)
+
+	switch {
+	case
+		errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded):
+		// context.DeadlineExceeded implements net.Error
+		return false
+	case errors.As(err, &rpcErr):
+		return rpcErr.Code == neorpc.ErrOutOfMemory.Code ||
+			rpcErr.Code == neorpc.InternalServerErrorCode
+	case errors.As(err, &netErr):
+		return true
+	default:
+		return false
+	}
+}
+
+// alreadySent checks whether err returned by the sending attempt of the
+// transaction means that one of the previous attempts has reached the RPC
+// node although it has failed on the client side (e.g. because of the
+// transport failure).
+func alreadySent(attempt int, err error) bool {
+	return attempt > 1 && errors.Is(err, neorpc.ErrAlreadyExists)
+}
+
+// withRetry calls f according to the retry policy of the Client until
+// it succeeds, fails with non-transient error or the context is done.
+// Returns the last error of f.
+//
+// withRetry must be called with switchLock read-locked. The lock is released
+// while waiting between the attempts so as not to block the RPC switch and
+// closing of the Client. If the Client becomes inactive meanwhile,
+// ErrConnectionLost is returned.
+func (c *Client) withRetry(ctx context.Context, f func() error) error {
+	p := c.cfg.retry
+	delay := p.BaseDelay
+
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || attempt >= p.MaxAttempts || !isTransientError(err) {
+			return err
+		}
+
+		c.logger.Debug("RPC call failed with transient error, retrying",
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.Error(err))
+
+		c.switchLock.RUnlock()
+		done := sleep(ctx, delay)
+		c.switchLock.RLock()
+
+		if done {
+			return err
+		}
+
+		if c.inactive {
+			return ErrConnectionLost
+		}
+
+		if p.Multiplier > 1 {
+			delay = time.Duration(float64(delay) * p.Multiplier)
+		}
+	}
+}
+
+// sleep waits for the specified duration. Returns true if the context is done
+// before.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return true
+	case <-t.C:
+		return false
+	}
+}
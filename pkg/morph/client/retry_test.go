@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/neorpc"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestClient_withRetry(t *testing.T) {
+	c := &Client{
+		logger:     &logger.Logger{Logger: zap.NewNop()},
+		switchLock: new(sync.RWMutex),
+		cfg: cfg{
+			retry: RetryPolicy{
+				MaxAttempts: 3,
+				Multiplier:  2,
+			},
+		},
+	}
+
+	// withRetry is called under the read lock
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	check := func(callErr error, expAttempts int) {
+		var attempts int
+
+		err := c.withRetry(context.Background(), func() error {
+			attempts++
+			return callErr
+		})
+		require.ErrorIs(t, err, callErr)
+		require.Equal(t, expAttempts, attempts)
+	}
+
+	check(neorpc.ErrOutOfMemory, 3)
+	check(neorpc.NewInternalServerError("unavailable"), 3)
+	check(&net.OpError{Op: "read", Err: errors.New("connection reset")}, 3)
+	check(errors.New("unknown error"), 1)
+	check(neorpc.ErrPolicyFail, 1)
+	check(neorpc.ErrValidationFailed, 1)
+	check(ErrConnectionLost, 1)
+	check(context.DeadlineExceeded, 1)
+	check(wrapNeoFSError(FaultError{State: "FAULT"}), 1)
+
+	t.Run("success", func(t *testing.T) {
+		var attempts int
+
+		err := c.withRetry(context.Background(), func() error {
+			attempts++
+			if attempts < 2 {
+				return neorpc.NewInternalServerError("unavailable")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+	})
+
+	t.Run("lock is released between attempts", func(t *testing.T) {
+		c := &Client{
+			logger:     &logger.Logger{Logger: zap.NewNop()},
+			switchLock: new(sync.RWMutex),
+			cfg: cfg{
+				retry: RetryPolicy{
+					MaxAttempts: 3,
+					BaseDelay:   200 * time.Millisecond,
+				},
+			},
+		}
+
+		c.switchLock.RLock()
+		defer c.switchLock.RUnlock()
+
+		var attempts int
+
+		err := c.withRetry(context.Background(), func() error {
+			attempts++
+			if attempts == 1 {
+				// the Client is switched to inactive mode during the delay
+				go func() {
+					c.switchLock.Lock()
+					c.inactive = true
+					c.switchLock.Unlock()
+				}()
+			}
+			return neorpc.ErrOutOfMemory
+		})
+		require.ErrorIs(t, err, ErrConnectionLost)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("no retries by default", func(t *testing.T) {
+		c := &Client{cfg: *defaultConfig(), switchLock: new(sync.RWMutex)}
+
+		var attempts int
+
+		_ = c.withRetry(context.Background(), func() error {
+			attempts++
+			return neorpc.ErrOutOfMemory
+		})
+		require.Equal(t, 1, attempts)
+	})
+}
+
+func TestAlreadySent(t *testing.T) {
+	err := fmt.Errorf("send: %w", neorpc.ErrAlreadyExists)
+
+	require.False(t, alreadySent(1, err))
+	require.True(t, alreadySent(2, err))
+	require.False(t, alreadySent(2, neorpc.ErrOutOfMemory))
+	require.False(t, alreadySent(2, nil))
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+)
+
+// Signer signs transactions sent by Client on behalf of its account. It allows
+// keeping the account key out of the process memory, e.g. in HSM.
+type Signer interface {
+	// SignTx returns the signature of the transaction for the network
+	// with the given magic.
+	SignTx(net netmode.Magic, tx *transaction.Transaction) ([]byte, error)
+
+	// PublicKey returns the public key of the account.
+	PublicKey() *keys.PublicKey
+
+	// ScriptHash returns the script hash of the account.
+	ScriptHash() util.Uint160
+}
+
+// localSigner is a Signer using the private key from the memory.
+type localSigner struct {
+	key *keys.PrivateKey
+}
+
+func (x localSigner) SignTx(net netmode.Magic, tx *transaction.Transaction) ([]byte, error) {
+	return x.key.SignHashable(uint32(net), tx), nil
+}
+
+func (x localSigner) PublicKey() *keys.PublicKey {
+	return x.key.PublicKey()
+}
+
+func (x localSigner) ScriptHash() util.Uint160 {
+	return x.key.GetScriptHash()
+}
+
+// signerAccount returns the account of s without the private key. It is
+// enough to build transactions and calculate their fees.
+func signerAccount(s Signer) *wallet.Account {
+	return &wallet.Account{
+		Address: address.Uint160ToString(s.ScriptHash()),
+		Contract: &wallet.Contract{
+			Script: s.PublicKey().GetVerificationScript(),
+			Parameters: []wallet.ContractParam{{
+				Name: "parameter0",
+				Type: smartcontract.SignatureType,
+			}},
+		},
+	}
+}
+
+// signTx adds the witness of the Client account to tx built by the Client
+// actor.
+//
+// Must be called with switchLock held.
+func (c *Client) signTx(tx *transaction.Transaction) error {
+	return signTx(c.accSigner, c.rpcActor.GetNetwork(), tx)
+}
+
+// signTx sets the witness of the first transaction signer made by s.
+func signTx(s Signer, net netmode.Magic, tx *transaction.Transaction) error {
+	sig, err := s.SignTx(net, tx)
+	if err != nil {
+		return fmt.Errorf("could not sign transaction: %w", err)
+	}
+
+	w := io.NewBufBinWriter()
+	emit.Bytes(w.BinWriter, sig)
+
+	tx.Scripts[0] = transaction.Witness{
+		InvocationScript:   w.Bytes(),
+		VerificationScript: s.PublicKey().GetVerificationScript(),
+	}
+
+	return nil
+}
+
+// makeTx builds a transaction from the result of the test run, filters it
+// through the hook and signs it with the Client signer.
+//
+// Must be called with switchLock held.
+func (c *Client) makeTx(r *result.Invoke, hook func(*result.Invoke, *transaction.Transaction) error) (*transaction.Transaction, error) {
+	tx, err := c.rpcActor.MakeUnsignedUncheckedRun(r.Script, r.GasConsumed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = hook(r, tx); err != nil {
+		return nil, err
+	}
+
+	if err = c.signTx(tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// makeCall is the same as makeTx but test-runs the contract method first.
+//
+// Must be called with switchLock held.
+func (c *Client) makeCall(contract util.Uint160, method string, hook func(*result.Invoke, *transaction.Transaction) error, args ...interface{}) (*transaction.Transaction, error) {
+	r, err := c.rpcActor.Call(contract, method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("test invocation failed: %w", err)
+	}
+
+	return c.makeTx(r, hook)
+}
+
+// makeRun is the same as makeTx but test-runs the script first.
+//
+// Must be called with switchLock held.
+func (c *Client) makeRun(script []byte, hook func(*result.Invoke, *transaction.Transaction) error) (*transaction.Transaction, error) {
+	r, err := c.rpcActor.Run(script)
+	if err != nil {
+		return nil, fmt.Errorf("test invocation failed: %w", err)
+	}
+
+	return c.makeTx(r, hook)
+}
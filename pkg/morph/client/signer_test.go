@@ -0,0 +1,67 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+type testSigner struct {
+	localSigner
+	err error
+}
+
+func (x testSigner) SignTx(net netmode.Magic, tx *transaction.Transaction) ([]byte, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	return x.localSigner.SignTx(net, tx)
+}
+
+func TestSignerAccount(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	acc := signerAccount(localSigner{key: key})
+	local := wallet.NewAccountFromPrivateKey(key)
+
+	require.Nil(t, acc.PrivateKey())
+	require.Equal(t, local.Address, acc.Address)
+	require.Equal(t, local.Contract.Script, acc.Contract.Script)
+	require.Equal(t, key.GetScriptHash(), acc.ScriptHash())
+	require.Equal(t, acc.ScriptHash(), acc.Contract.ScriptHash())
+}
+
+func TestSignTx(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	const net = netmode.UnitTestNet
+
+	newTx := func() *transaction.Transaction {
+		tx := transaction.New([]byte{1}, 1)
+		tx.Signers = []transaction.Signer{{Account: key.GetScriptHash()}}
+		tx.Scripts = make([]transaction.Witness, 1)
+		return tx
+	}
+
+	tx := newTx()
+	require.NoError(t, signTx(testSigner{localSigner: localSigner{key: key}}, net, tx))
+
+	w := tx.Scripts[0]
+	require.Equal(t, key.PublicKey().GetVerificationScript(), w.VerificationScript)
+	require.Len(t, w.InvocationScript, 2+keys.SignatureLen)
+	require.Equal(t, []byte{byte(opcode.PUSHDATA1), keys.SignatureLen}, w.InvocationScript[:2])
+	require.True(t, key.PublicKey().VerifyHashable(w.InvocationScript[2:], uint32(net), tx))
+
+	errSign := errors.New("any error")
+	err = signTx(testSigner{localSigner: localSigner{key: key}, err: errSign}, net, newTx())
+	require.ErrorIs(t, err, errSign)
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+)
+
+// TxStatus describes the state of the persisted transaction.
+type TxStatus struct {
+	// Halted is true if the transaction has been successfully executed.
+	Halted bool
+
+	// Height is the height of the block the transaction has been persisted in.
+	Height uint32
+}
+
+// applicationLogGetter is an RPC client that can get
+// the application log of the persisted transaction.
+type applicationLogGetter interface {
+	GetApplicationLog(util.Uint256, *trigger.Type) (*result.ApplicationLog, error)
+}
+
+// txStatusGetter is an RPC client that can get the state of the persisted
+// transaction.
+type txStatusGetter interface {
+	transactionHeightGetter
+	applicationLogGetter
+}
+
+// ApplicationLog returns the result of the persisted transaction execution
+// with the Application trigger including the resulting stack and the
+// emitted notifications.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) ApplicationLog(h util.Uint256) (*state.AppExecResult, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	return applicationLog(c.client, h)
+}
+
+func applicationLog(cli applicationLogGetter, h util.Uint256) (*state.AppExecResult, error) {
+	trig := trigger.Application
+
+	aer, err := cli.GetApplicationLog(h, &trig)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(aer.Executions) == 0 {
+		return nil, fmt.Errorf("no application executions of tx %s", h.StringLE())
+	}
+
+	return &state.AppExecResult{
+		Container: aer.Container,
+		Execution: aer.Executions[0],
+	}, nil
+}
+
+// TxStatuses returns the states of the persisted transactions with the given
+// hashes. The states never change once transactions are persisted, so they
+// are cached along with the heights (see TxHeight) and the RPC node is
+// requested for the unknown transactions only. The node is requested for
+// each transaction separately since neo-go RPC client provides no batch
+// call for it.
+//
+// If the state of some transactions can't be got (e.g. they are not persisted
+// yet), the states of the rest ones are returned along with the error
+// describing the failures.
+//
+// Returns ErrConnectionLost if client has not been able to establish
+// connection to any of passed RPC endpoints.
+func (c *Client) TxStatuses(hashes []util.Uint256) (map[util.Uint256]TxStatus, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	return c.cache.txStatuses(c.client, hashes)
+}
+
+func (c cache) txStatuses(cli txStatusGetter, hashes []util.Uint256) (map[util.Uint256]TxStatus, error) {
+	var (
+		res      = make(map[util.Uint256]TxStatus, len(hashes))
+		failed   = make(map[util.Uint256]struct{})
+		firstErr error
+		firstTx  util.Uint256
+	)
+
+	for _, h := range hashes {
+		if _, ok := res[h]; ok {
+			continue
+		} else if _, ok = failed[h]; ok {
+			continue
+		}
+
+		st, err := c.txStatus(cli, h)
+		if err != nil {
+			if firstErr == nil {
+				firstErr, firstTx = err, h
+			}
+
+			failed[h] = struct{}{}
+
+			continue
+		}
+
+		res[h] = st
+	}
+
+	if firstErr != nil {
+		return res, fmt.Errorf("could not get state of %d out of %d transactions, tx %s: %w",
+			len(failed), len(failed)+len(res), firstTx.StringLE(), firstErr)
+	}
+
+	return res, nil
+}
+
+// txStatus returns cached status of the transaction. If the status is not
+// cached, it is requested from the RPC client and stored in the cache.
+func (c cache) txStatus(cli txStatusGetter, h util.Uint256) (TxStatus, error) {
+	if e, ok := c.cachedTx(h); ok && e.statusKnown {
+		return e.status, nil
+	}
+
+	height, err := c.transactionHeight(cli, h)
+	if err != nil {
+		return TxStatus{}, fmt.Errorf("can't get transaction height: %w", err)
+	}
+
+	res, err := applicationLog(cli, h)
+	if err != nil {
+		return TxStatus{}, fmt.Errorf("can't get application log: %w", err)
+	}
+
+	st := TxStatus{
+		Halted: res.VMState.HasFlag(vmstate.Halt),
+		Height: height,
+	}
+
+	c.cacheTx(h, txCacheEntry{status: st, statusKnown: true})
+
+	return st, nil
+}
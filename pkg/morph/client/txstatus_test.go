@@ -0,0 +1,158 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/stretchr/testify/require"
+)
+
+type testTxStatusGetter struct {
+	heightCalls map[util.Uint256]int
+	logCalls    map[util.Uint256]int
+	heights     map[util.Uint256]uint32
+	states      map[util.Uint256]vmstate.State
+}
+
+func (x *testTxStatusGetter) GetTransactionHeight(h util.Uint256) (uint32, error) {
+	x.heightCalls[h]++
+
+	height, ok := x.heights[h]
+	if !ok {
+		return 0, errors.New("unknown transaction")
+	}
+
+	return height, nil
+}
+
+func (x *testTxStatusGetter) GetApplicationLog(h util.Uint256, _ *trigger.Type) (*result.ApplicationLog, error) {
+	if x.logCalls != nil {
+		x.logCalls[h]++
+	}
+
+	st, ok := x.states[h]
+	if !ok {
+		return nil, errors.New("unknown transaction")
+	}
+
+	return &result.ApplicationLog{
+		Container:  h,
+		Executions: []state.Execution{{VMState: st}},
+	}, nil
+}
+
+func TestCache_TxStatuses(t *testing.T) {
+	var (
+		c       = newClientCache()
+		halted  = util.Uint256{1}
+		faulted = util.Uint256{2}
+		unknown = util.Uint256{3}
+	)
+
+	cli := &testTxStatusGetter{
+		heightCalls: make(map[util.Uint256]int),
+		logCalls:    make(map[util.Uint256]int),
+		heights:     map[util.Uint256]uint32{halted: 10, faulted: 20},
+		states:      map[util.Uint256]vmstate.State{halted: vmstate.Halt, faulted: vmstate.Fault},
+	}
+
+	res, err := c.txStatuses(cli, []util.Uint256{halted, faulted, halted})
+	require.NoError(t, err)
+	require.Equal(t, map[util.Uint256]TxStatus{
+		halted:  {Halted: true, Height: 10},
+		faulted: {Halted: false, Height: 20},
+	}, res)
+	require.Equal(t, 1, cli.heightCalls[halted])
+
+	res, err = c.txStatuses(cli, []util.Uint256{halted, unknown, faulted})
+	require.Error(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, TxStatus{Halted: true, Height: 10}, res[halted])
+
+	// statuses are cached, only unknown transaction is requested again
+	for _, h := range []util.Uint256{halted, faulted} {
+		require.Equal(t, 1, cli.heightCalls[h])
+		require.Equal(t, 1, cli.logCalls[h])
+	}
+	require.Equal(t, 1, cli.heightCalls[unknown])
+
+	_, err = c.txStatuses(cli, []util.Uint256{unknown})
+	require.Error(t, err)
+	require.Equal(t, 2, cli.heightCalls[unknown])
+
+	// heights are taken from the cached statuses
+	height, err := c.transactionHeight(cli, faulted)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, height)
+	require.Equal(t, 1, cli.heightCalls[faulted])
+
+	t.Run("cached height", func(t *testing.T) {
+		h := util.Uint256{4}
+		cli.heights[h] = 30
+		cli.states[h] = vmstate.Halt
+
+		_, err := c.transactionHeight(cli, h)
+		require.NoError(t, err)
+
+		res, err := c.txStatuses(cli, []util.Uint256{h})
+		require.NoError(t, err)
+		require.Equal(t, TxStatus{Halted: true, Height: 30}, res[h])
+		require.Equal(t, 1, cli.heightCalls[h])
+		require.Equal(t, 1, cli.logCalls[h])
+
+		// status replaces the height-only entry
+		res, err = c.txStatuses(cli, []util.Uint256{h})
+		require.NoError(t, err)
+		require.Equal(t, TxStatus{Halted: true, Height: 30}, res[h])
+		require.Equal(t, 1, cli.logCalls[h])
+
+		e, ok := c.cachedTx(h)
+		require.True(t, ok)
+		require.True(t, e.statusKnown)
+	})
+}
+
+func TestClient_TxStatuses(t *testing.T) {
+	c := &Client{switchLock: new(sync.RWMutex), inactive: true}
+
+	_, err := c.TxStatuses([]util.Uint256{{1}})
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+func TestApplicationLog(t *testing.T) {
+	var (
+		h   = util.Uint256{1}
+		cli = &testTxStatusGetter{
+			states: map[util.Uint256]vmstate.State{h: vmstate.Halt},
+		}
+	)
+
+	res, err := applicationLog(cli, h)
+	require.NoError(t, err)
+	require.Equal(t, h, res.Container)
+	require.Equal(t, vmstate.Halt, res.VMState)
+
+	_, err = applicationLog(cli, util.Uint256{2})
+	require.Error(t, err)
+
+	_, err = applicationLog(testAppLogGetter{}, h)
+	require.Error(t, err)
+
+	c := &Client{switchLock: new(sync.RWMutex), inactive: true}
+
+	_, err = c.ApplicationLog(h)
+	require.ErrorIs(t, err, ErrConnectionLost)
+}
+
+// testAppLogGetter returns application logs without executions.
+type testAppLogGetter struct{}
+
+func (testAppLogGetter) GetApplicationLog(h util.Uint256, _ *trigger.Type) (*result.ApplicationLog, error) {
+	return &result.ApplicationLog{Container: h}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/nspcc-dev/neo-go/pkg/core/block"
 	"github.com/nspcc-dev/neo-go/pkg/core/state"
@@ -119,6 +120,10 @@ type listener struct {
 
 const newListenerFailMsg = "could not instantiate Listener"
 
+// subscriberCloseTimeout is a time to wait for the pending sidechain
+// calls on listener stop.
+const subscriberCloseTimeout = 10 * time.Second
+
 var (
 	errNilLogger = errors.New("nil logger")
 
@@ -593,7 +598,12 @@ func (l *listener) RegisterNotaryHandler(hi NotaryHandlerInfo) {
 // Stop closes subscription channel with remote neo node.
 func (l *listener) Stop() {
 	l.stopOnce.Do(func() {
-		l.subscriber.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), subscriberCloseTimeout)
+		defer cancel()
+
+		if err := l.subscriber.Close(ctx); err != nil {
+			l.log.Warn("could not close subscriber gracefully", zap.Error(err))
+		}
 	})
 }
 
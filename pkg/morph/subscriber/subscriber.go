@@ -23,7 +23,9 @@ type (
 		UnsubscribeForNotification()
 		BlockNotifications() (<-chan *block.Block, error)
 		SubscribeForNotaryRequests(mainTXSigner util.Uint160) (<-chan *result.NotaryRequestEvent, error)
-		Close()
+		// Close closes the underlying client. It waits for the pending
+		// calls no longer than the context allows.
+		Close(context.Context) error
 	}
 
 	subscriber struct {
@@ -87,8 +89,8 @@ func (s *subscriber) UnsubscribeForNotification() {
 	}
 }
 
-func (s *subscriber) Close() {
-	s.client.Close()
+func (s *subscriber) Close(ctx context.Context) error {
+	return s.client.Close(ctx)
 }
 
 func (s *subscriber) BlockNotifications() (<-chan *block.Block, error) {
@@ -20,7 +20,7 @@ func (exec *execCtx) executeLocal() {
 }
 
 func (exec *execCtx) formTombstone() (ok bool) {
-	tsLifetime, err := exec.svc.netInfo.TombstoneLifetime()
+	tsLifetime, err := exec.svc.netInfo.TombstoneLifetime(exec.address().Container())
 	if err != nil {
 		exec.status = statusUndefined
 		exec.err = err
@@ -7,6 +7,7 @@ import (
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -26,8 +27,8 @@ type NetworkInfo interface {
 	netmap.State
 
 	// Must return the lifespan of the tombstones
-	// in the NeoFS epochs.
-	TombstoneLifetime() (uint64, error)
+	// of the given container in the NeoFS epochs.
+	TombstoneLifetime(cid.ID) (uint64, error)
 
 	// Returns user ID of the local storage node. Result must not be nil.
 	// New tombstone objects will have the result as an owner ID if removal is executed w/o a session.
@@ -1,57 +1,237 @@
 package attributes
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 )
 
-const keyValueSeparator = ":"
+const (
+	keyValueSeparator = ":"
+	escChar           = `\`
+)
+
+// Limits restricts the read node attributes, see Options. Zero values mean
+// no limit. Lengths are measured in bytes after unescaping.
+type Limits struct {
+	// MaxCount is a maximum number of attributes.
+	MaxCount int
+	// MaxKeyLength is a maximum length of the attribute key.
+	MaxKeyLength int
+	// MaxValueLength is a maximum length of the attribute value.
+	MaxValueLength int
+}
+
+// Options configures reading of node attributes. Zero value corresponds to
+// ReadNodeAttributes behavior.
+type Options struct {
+	// Separator is a key-value separator used instead of ':', e.g. "Key=Value"
+	// attributes are read with "=" separator. Escaped separator is unescaped
+	// the same way as "\:". Separator must be a single character other than
+	// "\" and control characters.
+	Separator string
+
+	// Limits restricts the number and the length of the attributes.
+	Limits Limits
+
+	// MultiValue allows multiple values of the keys with "[]" suffix:
+	// "Key[]:Value1" and "Key[]:Value2" are stored as a single "Key" attribute
+	// with "Value1,Value2" value. Values are joined in the order of appearance
+	// after unescaping, and must not contain commas.
+	MultiValue bool
+
+	// Trim enables trimming of surrounding whitespace of keys and values, so
+	// "Region : US" is read as "Region" key with "US" value.
+	Trim bool
+}
 
 // ReadNodeAttributes parses node attributes from list of string in "Key:Value" format
 // and writes them into netmap.NodeInfo instance. Supports escaped symbols
-// "\:", "\/" and "\\".
+// "\:", "\/" and "\\". Unescaped "\" at the end of the line is an error.
 func ReadNodeAttributes(dst *netmap.NodeInfo, attrs []string) error {
+	return ReadNodeAttributesWithOptions(dst, attrs, Options{})
+}
+
+// ReadNodeAttributesWithOptions is the same as ReadNodeAttributes but reads
+// attributes according to the given options.
+func ReadNodeAttributesWithOptions(dst *netmap.NodeInfo, attrs []string, opts Options) error {
+	if opts.Separator != "" {
+		if err := checkSeparator(opts.Separator); err != nil {
+			return err
+		}
+	}
+
+	return readNodeAttributes(dst, attrs, opts)
+}
+
+func checkSeparator(sep string) error {
+	if utf8.RuneCountInString(sep) != 1 {
+		return fmt.Errorf("separator must be a single character, got '%s'", sep)
+	} else if sep == escChar {
+		return errors.New("escape character can't be a separator")
+	} else if err := checkControlCharacters(sep); err != nil {
+		return fmt.Errorf("invalid separator: %w", err)
+	}
+
+	return nil
+}
+
+const (
+	multiValueKeySuffix = "[]"
+	multiValueSeparator = ","
+)
+
+func readNodeAttributes(dst *netmap.NodeInfo, attrs []string, opts Options) error {
 	cache := make(map[string]struct{}, len(attrs))
+	multiValues := make(map[string][]string)
+
+	sep := opts.Separator
+	if sep == "" {
+		sep = keyValueSeparator
+	}
 
 	for i := range attrs {
-		line := replaceEscaping(attrs[i], false) // replaced escaped symbols with non-printable symbols
+		// control characters are used as sentinels in escaping
+		if err := checkControlCharacters(attrs[i]); err != nil {
+			return fmt.Errorf("invalid attribute #%d: %w", i, err)
+		}
 
-		words := strings.Split(line, keyValueSeparator)
+		line := replaceEscaping(attrs[i], sep, false) // replaced escaped symbols with non-printable symbols
+
+		// escape character left at the end does not escape anything
+		if strings.HasSuffix(line, escChar) {
+			return fmt.Errorf("invalid attribute #%d: trailing escape character", i)
+		}
+
+		words := strings.Split(line, sep)
 		if len(words) != 2 {
 			return errors.New("missing attribute key and/or value")
 		}
 
-		_, ok := cache[words[0]]
-		if ok {
-			return fmt.Errorf("duplicated keys %s", words[0])
-		}
+		// replace non-printable symbols with escaped symbols without escape character
+		key := replaceEscaping(words[0], sep, true)
+		value := replaceEscaping(words[1], sep, true)
 
-		cache[words[0]] = struct{}{}
+		if opts.Trim {
+			key = strings.TrimSpace(key)
+			value = strings.TrimSpace(value)
+		}
 
-		// replace non-printable symbols with escaped symbols without escape character
-		words[0] = replaceEscaping(words[0], true)
-		words[1] = replaceEscaping(words[1], true)
+		multiValue := opts.MultiValue && strings.HasSuffix(key, multiValueKeySuffix)
+		if multiValue {
+			key = strings.TrimSuffix(key, multiValueKeySuffix)
+		}
 
-		if words[0] == "" {
+		if key == "" {
 			return errors.New("empty key")
-		} else if words[1] == "" {
+		} else if value == "" {
 			return errors.New("empty value")
 		}
 
-		dst.SetAttribute(words[0], words[1])
+		if multiValue {
+			if strings.Contains(value, multiValueSeparator) {
+				return fmt.Errorf("value of multi-value key %s contains separator '%s'", key, multiValueSeparator)
+			}
+
+			vals, ok := multiValues[key]
+			if !ok {
+				if _, ok = cache[key]; ok {
+					return fmt.Errorf("duplicated keys %s", key)
+				}
+			}
+
+			multiValues[key] = append(vals, value)
+			value = strings.Join(multiValues[key], multiValueSeparator)
+		} else if _, ok := cache[key]; ok {
+			return fmt.Errorf("duplicated keys %s", key)
+		}
+
+		cache[key] = struct{}{}
+
+		if err := opts.Limits.check(len(cache), key, value); err != nil {
+			return err
+		}
+
+		dst.SetAttribute(key, value)
 	}
 
 	return nil
 }
 
-func replaceEscaping(target string, rollback bool) (s string) {
-	const escChar = `\`
+// ReadNodeAttributesFrom reads node attributes from newline-delimited r and
+// writes them into netmap.NodeInfo instance. Blank lines and lines starting
+// with '#' are skipped. See ReadNodeAttributesWithOptions for details.
+func ReadNodeAttributesFrom(dst *netmap.NodeInfo, r io.Reader, opts Options) error {
+	var attrs []string
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSuffix(sc.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		attrs = append(attrs, line)
+	}
+
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("read attributes: %w", err)
+	}
+
+	return ReadNodeAttributesWithOptions(dst, attrs, opts)
+}
+
+// WriteNodeAttributes returns node attributes in "Key:Value" format accepted
+// by ReadNodeAttributes: "\" and ":" symbols are escaped.
+func WriteNodeAttributes(src *netmap.NodeInfo) []string {
+	res := make([]string, 0, src.NumberOfAttributes())
+
+	src.IterateAttributes(func(key, value string) {
+		res = append(res, escape(key)+keyValueSeparator+escape(value))
+	})
+
+	return res
+}
+
+func escape(s string) string {
+	s = strings.ReplaceAll(s, escChar, escChar+escChar)
+	return strings.ReplaceAll(s, keyValueSeparator, escChar+keyValueSeparator)
+}
+
+// check checks n-th attribute against the limits.
+func (l Limits) check(n int, key, value string) error {
+	switch {
+	case l.MaxCount > 0 && n > l.MaxCount:
+		return fmt.Errorf("too many attributes, max %d, first exceeding key %s", l.MaxCount, key)
+	case l.MaxKeyLength > 0 && len(key) > l.MaxKeyLength:
+		return fmt.Errorf("key %s is too long: %d, max %d", key, len(key), l.MaxKeyLength)
+	case l.MaxValueLength > 0 && len(value) > l.MaxValueLength:
+		return fmt.Errorf("value of key %s is too long: %d, max %d", key, len(value), l.MaxValueLength)
+	default:
+		return nil
+	}
+}
+
+// checkControlCharacters returns an error if s contains ASCII control
+// characters (including DEL) other than tab.
+func checkControlCharacters(s string) error {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 0x20 || s[i] == 0x7F) && s[i] != '\t' {
+			return fmt.Errorf("control character 0x%02x at position %d", s[i], i)
+		}
+	}
+
+	return nil
+}
 
+func replaceEscaping(target, sep string, rollback bool) (s string) {
 	var (
-		oldKVSep = escChar + keyValueSeparator
+		oldKVSep = escChar + sep
 		oldEsc   = escChar + escChar
 		newKVSep = string(uint8(2))
 		newEsc   = string(uint8(3))
@@ -59,7 +239,7 @@ func replaceEscaping(target string, rollback bool) (s string) {
 
 	if rollback {
 		oldKVSep, oldEsc = newKVSep, newEsc
-		newKVSep = keyValueSeparator
+		newKVSep = sep
 		newEsc = escChar
 	}
 
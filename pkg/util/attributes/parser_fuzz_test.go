@@ -0,0 +1,45 @@
+//go:build go1.18
+// +build go1.18
+
+package attributes_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/attributes"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzReadNodeAttributes(f *testing.F) {
+	for _, s := range []string{
+		"Key:Value",
+		`Key:Value\`,
+		`Key:Value\\`,
+		`K\:ey:Va\\\:lue`,
+		`Ke\/y:V\alue\`,
+		`\\:\`,
+		`Key\:Value`,
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		var node netmap.NodeInfo
+		if err := attributes.ReadNodeAttributes(&node, []string{s}); err != nil {
+			return
+		}
+
+		require.Equal(t, 1, node.NumberOfAttributes())
+
+		lines := attributes.WriteNodeAttributes(&node)
+
+		var res netmap.NodeInfo
+		require.NoError(t, attributes.ReadNodeAttributes(&res, lines))
+
+		node.IterateAttributes(func(key, value string) {
+			require.Equal(t, value, res.Attribute(key))
+		})
+		require.Equal(t, lines, attributes.WriteNodeAttributes(&res))
+	})
+}
@@ -1,6 +1,7 @@
 package attributes_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/util/attributes"
@@ -78,6 +79,16 @@ func TestParseV2Attributes(t *testing.T) {
 
 		err = attributes.ReadNodeAttributes(&node, []string{"//"})
 		require.Error(t, err)
+
+		err = attributes.ReadNodeAttributes(&node, []string{`Key:Value\`})
+		require.ErrorContains(t, err, "trailing escape")
+
+		err = attributes.ReadNodeAttributes(&node, []string{`Key:Value\\\`})
+		require.ErrorContains(t, err, "trailing escape")
+
+		err = attributes.ReadNodeAttributes(&node, []string{`Key:Value\\`})
+		require.NoError(t, err)
+		require.Equal(t, `Value\`, node.Attribute("Key"))
 	})
 
 	t.Run("correct", func(t *testing.T) {
@@ -97,3 +108,188 @@ func TestParseV2Attributes(t *testing.T) {
 		})
 	})
 }
+
+func TestReadNodeAttributesWithOptions_Limits(t *testing.T) {
+	attrs := []string{"Location:Europe", "StorageType:HDD"}
+
+	var node netmap.NodeInfo
+	require.NoError(t, attributes.ReadNodeAttributesWithOptions(&node, attrs, attributes.Options{Limits: attributes.Limits{}}))
+	require.NoError(t, attributes.ReadNodeAttributesWithOptions(&node, attrs, attributes.Options{Limits: attributes.Limits{
+		MaxCount:       2,
+		MaxKeyLength:   len("StorageType"),
+		MaxValueLength: len("Europe"),
+	}}))
+
+	err := attributes.ReadNodeAttributesWithOptions(&node, attrs, attributes.Options{Limits: attributes.Limits{MaxCount: 1}})
+	require.ErrorContains(t, err, "StorageType")
+
+	err = attributes.ReadNodeAttributesWithOptions(&node, attrs, attributes.Options{Limits: attributes.Limits{MaxKeyLength: len("Location")}})
+	require.ErrorContains(t, err, "StorageType")
+
+	err = attributes.ReadNodeAttributesWithOptions(&node, attrs, attributes.Options{Limits: attributes.Limits{MaxValueLength: len("HDD")}})
+	require.ErrorContains(t, err, "Location")
+}
+
+func TestReadNodeAttributesFrom(t *testing.T) {
+	var node netmap.NodeInfo
+
+	err := attributes.ReadNodeAttributesFrom(&node, strings.NewReader(`
+# node location
+Location:Europe
+
+StorageType:HDD
+K\:ey:Va\:lue
+`), attributes.Options{})
+	require.NoError(t, err)
+	require.Equal(t, 3, node.NumberOfAttributes())
+	require.Equal(t, "Europe", node.Attribute("Location"))
+	require.Equal(t, "HDD", node.Attribute("StorageType"))
+	require.Equal(t, "Va:lue", node.Attribute("K:ey"))
+
+	err = attributes.ReadNodeAttributesFrom(&node, strings.NewReader("Location:Europe\nLocation:Asia\n"), attributes.Options{})
+	require.Error(t, err)
+
+	node = netmap.NodeInfo{}
+	err = attributes.ReadNodeAttributesFrom(&node, strings.NewReader("Region = US\nCapability[] = SSD\nCapability[] = GPU\n"), attributes.Options{
+		Separator:  "=",
+		MultiValue: true,
+		Trim:       true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, node.NumberOfAttributes())
+	require.Equal(t, "US", node.Attribute("Region"))
+	require.Equal(t, "SSD,GPU", node.Attribute("Capability"))
+
+	err = attributes.ReadNodeAttributesFrom(&node, strings.NewReader("Location:Europe\nStorageType:HDD\n"), attributes.Options{
+		Limits: attributes.Limits{MaxCount: 1},
+	})
+	require.ErrorContains(t, err, "StorageType")
+}
+
+func TestReadNodeAttributesWithOptions_MultiValue(t *testing.T) {
+	var (
+		node       netmap.NodeInfo
+		multiValue = attributes.Options{MultiValue: true}
+	)
+
+	err := attributes.ReadNodeAttributesWithOptions(&node, []string{
+		"Capability[]:SSD",
+		"Location:Europe",
+		`Capability[]:GPU\:1`,
+	}, multiValue)
+	require.NoError(t, err)
+	require.Equal(t, 2, node.NumberOfAttributes())
+	require.Equal(t, "SSD,GPU:1", node.Attribute("Capability"))
+	require.Equal(t, "Europe", node.Attribute("Location"))
+
+	t.Run("disabled", func(t *testing.T) {
+		var node netmap.NodeInfo
+
+		err := attributes.ReadNodeAttributes(&node, []string{"Capability[]:SSD", "Capability[]:GPU"})
+		require.Error(t, err)
+	})
+
+	t.Run("mixed with single value", func(t *testing.T) {
+		var node netmap.NodeInfo
+
+		err := attributes.ReadNodeAttributesWithOptions(&node, []string{"Capability:SSD", "Capability[]:GPU"}, multiValue)
+		require.Error(t, err)
+
+		err = attributes.ReadNodeAttributesWithOptions(&node, []string{"Capability[]:SSD", "Capability:GPU"}, multiValue)
+		require.Error(t, err)
+	})
+
+	t.Run("separator in value", func(t *testing.T) {
+		var node netmap.NodeInfo
+
+		err := attributes.ReadNodeAttributesWithOptions(&node, []string{"Capability[]:SSD,GPU"}, multiValue)
+		require.Error(t, err)
+	})
+}
+
+func TestReadNodeAttributes_ControlCharacters(t *testing.T) {
+	var node netmap.NodeInfo
+
+	err := attributes.ReadNodeAttributes(&node, []string{"Location:Europe", "Key:Va\x02lue"})
+	require.ErrorContains(t, err, "attribute #1")
+	require.ErrorContains(t, err, "position 6")
+	require.Zero(t, node.Attribute("Key"))
+
+	err = attributes.ReadNodeAttributes(&node, []string{"K\x03ey:Value"})
+	require.Error(t, err)
+
+	err = attributes.ReadNodeAttributes(&node, []string{"Key:Val\x7Fue"})
+	require.ErrorContains(t, err, "0x7f")
+
+	err = attributes.ReadNodeAttributesWithOptions(&node, []string{"Key\x7FValue"}, attributes.Options{Separator: "\x7F"})
+	require.ErrorContains(t, err, "invalid separator")
+
+	err = attributes.ReadNodeAttributes(&node, []string{"Key:Va\tlue"})
+	require.NoError(t, err)
+}
+
+func TestWriteNodeAttributes(t *testing.T) {
+	var src netmap.NodeInfo
+	src.SetAttribute("Location", "Europe")
+	src.SetAttribute("K:ey", `Va\:l\ue`)
+	src.SetAttribute(`Ke\/y`, "a:b:c")
+
+	lines := attributes.WriteNodeAttributes(&src)
+	require.Equal(t, []string{
+		"Location:Europe",
+		`K\:ey:Va\\\:l\\ue`,
+		`Ke\\/y:a\:b\:c`,
+	}, lines)
+
+	var dst netmap.NodeInfo
+	require.NoError(t, attributes.ReadNodeAttributes(&dst, lines))
+	require.Equal(t, lines, attributes.WriteNodeAttributes(&dst))
+
+	src.IterateAttributes(func(key, value string) {
+		require.Equal(t, value, dst.Attribute(key))
+	})
+	require.Equal(t, src.NumberOfAttributes(), dst.NumberOfAttributes())
+}
+
+func TestReadNodeAttributesWithOptions_Trim(t *testing.T) {
+	attrs := []string{"Region : US", "\tStorageType:HDD "}
+
+	var node netmap.NodeInfo
+	require.NoError(t, attributes.ReadNodeAttributes(&node, attrs))
+	require.Equal(t, " US", node.Attribute("Region "))
+	require.Equal(t, "HDD ", node.Attribute("\tStorageType"))
+
+	node = netmap.NodeInfo{}
+	require.NoError(t, attributes.ReadNodeAttributesWithOptions(&node, attrs, attributes.Options{Trim: true}))
+	require.Equal(t, 2, node.NumberOfAttributes())
+	require.Equal(t, "US", node.Attribute("Region"))
+	require.Equal(t, "HDD", node.Attribute("StorageType"))
+
+	require.Error(t, attributes.ReadNodeAttributesWithOptions(&node, []string{"Region: "}, attributes.Options{Trim: true}))
+	require.Error(t, attributes.ReadNodeAttributesWithOptions(&node, []string{"Region:US", "Region :EU"}, attributes.Options{Trim: true}))
+}
+
+func TestReadNodeAttributesWithOptions_Separator(t *testing.T) {
+	var node netmap.NodeInfo
+
+	err := attributes.ReadNodeAttributesWithOptions(&node, []string{
+		"URL=grpcs://example.com:8080",
+		`K\=ey=Va\\lue`,
+		`Colon\:Key=Value`,
+	}, attributes.Options{Separator: "="})
+	require.NoError(t, err)
+	require.Equal(t, "grpcs://example.com:8080", node.Attribute("URL"))
+	require.Equal(t, `Va\lue`, node.Attribute("K=ey"))
+	require.Equal(t, "Value", node.Attribute(`Colon\:Key`))
+
+	require.Error(t, attributes.ReadNodeAttributesWithOptions(&node, []string{"Key:Value"}, attributes.Options{Separator: "="}))
+	require.Error(t, attributes.ReadNodeAttributesWithOptions(&node, []string{"Key=Value=1"}, attributes.Options{Separator: "="}))
+
+	for _, sep := range []string{"==", `\`, "\x02"} {
+		require.Error(t, attributes.ReadNodeAttributesWithOptions(&node, []string{"Key" + sep + "Value"}, attributes.Options{Separator: sep}), sep)
+	}
+
+	node = netmap.NodeInfo{}
+	require.NoError(t, attributes.ReadNodeAttributesWithOptions(&node, []string{"Ключ→Значение"}, attributes.Options{Separator: "→"}))
+	require.Equal(t, "Значение", node.Attribute("Ключ"))
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+// configTypes maps supported config file extensions to viper config types.
+var configTypes = map[string]string{
+	".yaml": "yaml",
+	".yml":  "yaml",
+	".json": "json",
+	".toml": "toml",
+}
+
+// ReadConfigDir reads all config files (YAML, JSON and TOML) from provided
+// directory in alphabetical order and merges its content with the current
+// viper configuration.
+func ReadConfigDir(v *viper.Viper, configDir string) error {
+	_, err := ReadConfigDirResult(v, configDir)
+	return err
+}
+
+// ReadConfigDirResult is like ReadConfigDir but also returns paths of the
+// merged files in the merge order, so the file that set the resulting value
+// is the last one in the list containing it. In case of error the files
+// merged before the failure are returned.
+func ReadConfigDirResult(v *viper.Viper, configDir string) ([]string, error) {
+	return readConfigDir(v, configDir, "")
+}
+
+// ReadConfigDirFiltered is like ReadConfigDir but merges only files with base
+// names matching the provided filepath.Match pattern (e.g. "[0-9][0-9]-*"),
+// in addition to the extension check.
+func ReadConfigDirFiltered(v *viper.Viper, configDir, pattern string) error {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid config file pattern '%s': %w", pattern, err)
+	}
+
+	_, err := readConfigDir(v, configDir, pattern)
+	return err
+}
+
+// readConfigDir merges config files from the directory. Empty pattern matches
+// all files.
+func readConfigDir(v *viper.Viper, configDir, pattern string) ([]string, error) {
+	entries, err := os.ReadDir(configDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []string
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		cfgType, ok := configTypes[filepath.Ext(entry.Name())]
+		if !ok {
+			continue
+		}
+
+		if pattern != "" {
+			if ok, _ = filepath.Match(pattern, entry.Name()); !ok {
+				continue
+			}
+		}
+
+		fileName := filepath.Join(configDir, entry.Name())
+		if err = mergeConfig(v, fileName, cfgType); err != nil {
+			return merged, err
+		}
+
+		merged = append(merged, fileName)
+	}
+
+	return merged, nil
+}
+
+// ReadConfigDirRecursive is like ReadConfigDir but also reads config files
+// from all subdirectories of the provided directory.
+//
+// Files are merged in the filepath.WalkDir order: entries of each directory
+// are visited in lexical order and the content of a subdirectory is merged
+// at the position of the subdirectory name. So there is no precedence of
+// deeper files over shallower ones (or vice versa): for directory with
+// "10-a.yaml", "20-b/c.yaml" and "30-d.yaml" files the latter overrides
+// values from both the former ones.
+func ReadConfigDirRecursive(v *viper.Viper, configDir string) error {
+	return filepath.WalkDir(configDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		cfgType, ok := configTypes[filepath.Ext(entry.Name())]
+		if !ok {
+			return nil
+		}
+
+		return mergeConfig(v, path, cfgType)
+	})
+}
+
+// mergeConfig reads config file of the given type and merges its content with
+// the current viper configuration.
+func mergeConfig(v *viper.Viper, fileName, cfgType string) (err error) {
+	cfgFile, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		errClose := cfgFile.Close()
+		if err == nil {
+			err = errClose
+		}
+	}()
+
+	v.SetConfigType(cfgType)
+
+	return v.MergeConfig(cfgFile)
+}
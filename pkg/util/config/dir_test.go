@@ -0,0 +1,113 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfigDir(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10-a.yaml"), []byte("key: a\nfirst: 1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-b.yml"), []byte("key: b\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "30-c.txt"), []byte("key: c\n"), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "40-d.yaml"), 0700))
+
+	v := viper.New()
+	require.NoError(t, ReadConfigDir(v, dir))
+	require.Equal(t, "b", v.GetString("key"))
+	require.Equal(t, 1, v.GetInt("first"))
+
+	require.Error(t, ReadConfigDir(viper.New(), filepath.Join(dir, "missing")))
+}
+
+func TestReadConfigDirResult(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-b.yml"), []byte("key: b\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10-a.yaml"), []byte("key: a\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "30-c.txt"), []byte("key: c\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "40-d.json"), []byte("{invalid"), 0600))
+
+	merged, err := ReadConfigDirResult(viper.New(), dir)
+	require.Error(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "10-a.yaml"),
+		filepath.Join(dir, "20-b.yml"),
+	}, merged)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "40-d.json")))
+
+	v := viper.New()
+	merged, err = ReadConfigDirResult(v, dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "10-a.yaml"),
+		filepath.Join(dir, "20-b.yml"),
+	}, merged)
+	require.Equal(t, "b", v.GetString("key"))
+}
+
+func TestReadConfigDir_MixedTypes(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10-a.yaml"), []byte("key: a\nfirst: 1\nsection:\n  x: 1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-b.json"), []byte(`{"key": "b", "section": {"x": 2}}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "30-c.toml"), []byte("second = 2\n"), 0600))
+
+	v := viper.New()
+	require.NoError(t, ReadConfigDir(v, dir))
+	require.Equal(t, "b", v.GetString("key"))
+	require.Equal(t, 1, v.GetInt("first"))
+	require.Equal(t, 2, v.GetInt("section.x"))
+	require.Equal(t, 2, v.GetInt("second"))
+}
+
+func TestReadConfigDirRecursive(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "20-b", "nested"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10-a.yaml"), []byte("key: a\nfirst: 1\nsecond: 1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-b", "1.yaml"), []byte("key: b\nsecond: 2\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-b", "nested", "1.yml"), []byte("third: 3\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-b", "2.txt"), []byte("key: x\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "30-c.yaml"), []byte("key: c\n"), 0600))
+
+	v := viper.New()
+	require.NoError(t, ReadConfigDirRecursive(v, dir))
+	require.Equal(t, "c", v.GetString("key"))
+	require.Equal(t, 1, v.GetInt("first"))
+	require.Equal(t, 2, v.GetInt("second"))
+	require.Equal(t, 3, v.GetInt("third"))
+
+	v = viper.New()
+	require.NoError(t, ReadConfigDir(v, dir))
+	require.Equal(t, 0, v.GetInt("third"))
+
+	require.Error(t, ReadConfigDirRecursive(viper.New(), filepath.Join(dir, "missing")))
+}
+
+func TestReadConfigDirFiltered(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10-a.yaml"), []byte("key: a\nfirst: 1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "20-b.yml"), []byte("key: b\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "30-c.txt"), []byte("key: c\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "node.yaml"), []byte("key: node\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "40-d.yaml.disabled"), []byte("key: d\n"), 0600))
+
+	v := viper.New()
+	require.NoError(t, ReadConfigDirFiltered(v, dir, "[0-9][0-9]-*"))
+	require.Equal(t, "b", v.GetString("key"))
+	require.Equal(t, 1, v.GetInt("first"))
+
+	v = viper.New()
+	require.NoError(t, ReadConfigDirFiltered(v, dir, "10-*"))
+	require.Equal(t, "a", v.GetString("key"))
+
+	require.Error(t, ReadConfigDirFiltered(viper.New(), dir, "[0-9"))
+}